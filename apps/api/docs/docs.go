@@ -15,6 +15,130 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/articles/search": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Full-text search over the articles ingested from the user's data sources, ranked by relevance, with words stemmed in Portuguese or English. Copies of a story read from several sources are merged. Pages are read with the nextCursor of the previous page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "Search articles of the user's data sources",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "example": "arquitetura hexagonal",
+                        "description": "Search text; supports \\",
+                        "name": "q",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pt",
+                            "en"
+                        ],
+                        "type": "string",
+                        "description": "Stemming language of q: pt or en (detected when omitted)",
+                        "name": "lang",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "relevance",
+                            "newest",
+                            "oldest"
+                        ],
+                        "type": "string",
+                        "description": "relevance (default with q), newest (default without q) or oldest",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"rss,hackernews\"",
+                        "description": "Comma-separated data source types",
+                        "name": "sourceType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "Hacker News",
+                        "description": "Source name, like the title of a feed",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "\"go,ai\"",
+                        "description": "Comma-separated tags",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-05-01",
+                        "description": "Published after (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "2024-06-01",
+                        "description": "Published before (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "example": 20,
+                        "description": "Max articles (default 20, max 100)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.ArticleSearchResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
         "/articles/suggestions": {
             "get": {
                 "security": [
@@ -22,7 +146,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Returns a list of technical articles from user-configured sources (RSS, dev.to, Hacker News)",
+                "description": "Returns a page of the articles ingested from the user's data sources (see GET /sources/types), newest first, along with the sources that could not be read. Sources are read in the background; a newly added source starts being read on the first request and is counted in pendingSources until its articles are in.",
                 "produces": [
                     "application/json"
                 ],
@@ -59,22 +183,26 @@ const docTemplate = `{
                         "name": "tags",
                         "in": "query"
                     },
+                    {
+                        "type": "integer",
+                        "example": 1,
+                        "description": "Page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
                         "example": 10,
-                        "description": "Max articles (default 6, max 100)",
+                        "description": "Max articles per page (default 6, max 100)",
                         "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK\" x-example([{\"title\":\"Go 1.22 Released\",\"url\":\"https://dev.to/golang/go-1-22-released-1234\",\"source\":\"DEV Community\",\"publishedAt\":\"2024-05-01T12:00:00Z\",\"summary\":\"Resumo do artigo em português...\",\"tags\":[\"go\",\"release\"]}])",
+                        "description": "OK\" x-example({\"articles\":[{\"id\":\"665f1c2e8b3e4a0012345678\",\"title\":\"Go 1.22 Released\",\"url\":\"https://dev.to/golang/go-1-22-released-1234\",\"source\":\"DEV Community\",\"publishedAt\":\"2024-05-01T12:00:00Z\",\"summary\":\"Resumo do artigo em português...\",\"tags\":[\"go\",\"release\"],\"sourceType\":\"devto\",\"canonicalUrl\":\"https://dev.to/golang/go-1-22-released-1234\",\"firstSeenAt\":\"2024-05-01T12:10:00Z\",\"fetchedAt\":\"2024-05-01T12:10:00Z\"}],\"sourceErrors\":[{\"type\":\"rss\",\"url\":\"https://example.com/feed.xml\",\"error\":\"https://example.com/feed.xml returned status 404\"}],\"page\":1,\"hasMore\":true})",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/services.Article"
-                            }
+                            "$ref": "#/definitions/services.ArticleSuggestions"
                         }
                     },
                     "401": {
@@ -145,6 +273,48 @@ const docTemplate = `{
                 }
             }
         },
+        "/auth/devto/disconnect": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes the dev.to API key from the user profile",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DevTo"
+                ],
+                "summary": "Disconnect dev.to account",
+                "responses": {
+                    "200": {
+                        "description": "Exemplo: {\\\"message\\\": \\\"dev.to disconnected successfully\\\" }",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
         "/auth/google/callback": {
             "get": {
                 "description": "Handles Google OpenID Connect callback, authenticates or creates user, returns JWT and user object",
@@ -263,13 +433,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/linkedin/publish-callback": {
-            "get": {
+        "/auth/linkedin/disconnect": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
+                "description": "Removes LinkedIn access token and person URN from user profile",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LinkedIn"
+                ],
+                "summary": "Disconnect LinkedIn account",
+                "responses": {
+                    "200": {
+                        "description": "Exemplo: {\\\"message\\\": \\\"LinkedIn disconnected successfully\\\" }",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/linkedin/publish-callback": {
+            "get": {
                 "description": "Handles LinkedIn OAuth callback for publishing, saves access token to user",
                 "produces": [
                     "application/json"
@@ -288,14 +495,8 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Exemplo: {\\\"access_token\\\": \\\"...\\\" }",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
+                    "302": {
+                        "description": "Redirects to frontend profile page"
                     },
                     "400": {
                         "description": "Exemplo: {\\\"error\\\": \\\"Missing code from LinkedIn\\\" }",
@@ -399,7 +600,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/app.loginRequest"
+                            "$ref": "#/definitions/app.LoginRequest"
                         }
                     }
                 ],
@@ -440,7 +641,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/app.refreshRequest"
+                            "$ref": "#/definitions/app.RefreshTokenRequest"
                         }
                     }
                 ],
@@ -481,7 +682,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/app.registerRequest"
+                            "$ref": "#/definitions/app.RegisterRequest"
                         }
                     }
                 ],
@@ -522,7 +723,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/app.socialLoginRequest"
+                            "$ref": "#/definitions/app.SocialLoginRequest"
                         }
                     }
                 ],
@@ -543,83 +744,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/linkedin/publish": {
-            "post": {
+        "/blogs": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Publishes a post on LinkedIn for the authenticated user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Returns the user's connected WordPress and Ghost sites, without their credentials",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "LinkedIn"
-                ],
-                "summary": "Publish a post on LinkedIn",
-                "parameters": [
-                    {
-                        "description": "Post content",
-                        "name": "input",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/app.publishLinkedInPostRequest"
-                        }
-                    }
+                    "Blogs"
                 ],
+                "summary": "List connected blogs",
                 "responses": {
                     "200": {
-                        "description": "Exemplo: {\\\"status\\\": \\\"published\\\", \\\"linkedinPostId\\\": \\\"urn:li:share:...\\\" }",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.BlogConnection"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Exemplo: {\\\"error\\\": \\\"Missing text\\\" }",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "401": {
-                        "description": "Exemplo: {\\\"error\\\": \\\"Unauthorized\\\" }",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "500": {
-                        "description": "Exemplo: {\\\"error\\\": \\\"Failed to publish on LinkedIn\\\" }",
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
-                    }
-                }
-            }
-        },
-        "/me": {
-            "get": {
-                "description": "Returns the full user object for the authenticated user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "User"
-                ],
-                "summary": "Get authenticated user profile",
-                "responses": {
-                    "200": {
-                        "description": "Exemplo de resposta: {\\\"id\\\":\\\"123\\\",\\\"name\\\":\\\"João Silva\\\",\\\"email\\\":\\\"joao@exemplo.com\\\",\\\"avatarUrl\\\":\\\"https://exemplo.com/avatar.jpg\\\",\\\"provider\\\":\\\"google\\\",\\\"providerId\\\":\\\"abc123\\\",\\\"openAiApiKey\\\":\\\"sk-...\\\",\\\"openAiModel\\\":\\\"gpt-4\\\",\\\"dataSources\\\":[{\\\"type\\\":\\\"rss\\\",\\\"url\\\":\\\"https://martinfowler.com/feed.atom\\\",\\\"tags\\\":[\\\"architecture\\\",\\\"ai\\\"]}],\\\"createdAt\\\":\\\"2024-01-01T00:00:00Z\\\",\\\"updatedAt\\\":\\\"2024-01-01T00:00:00Z\\\"}",
-                        "schema": {
-                            "$ref": "#/definitions/models.User"
-                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -628,8 +783,8 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -637,13 +792,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update OpenAI and data sources for the authenticated user",
+                "description": "Saves a WordPress site (username and application password) or a Ghost site (Admin API key) after checking that the site accepts the credentials. The site must be a public https address. Credentials are never returned.",
                 "consumes": [
                     "application/json"
                 ],
@@ -651,25 +806,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Blogs"
                 ],
-                "summary": "Update user profile/configuration",
+                "summary": "Connect a self-hosted blog",
                 "parameters": [
                     {
-                        "description": "Profile update info",
+                        "description": "Blog site and credentials",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/app.updateProfileRequest"
+                            "$ref": "#/definitions/app.BlogConnectionRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Exemplo de resposta: {\\\"id\\\":\\\"123\\\",\\\"name\\\":\\\"João Silva\\\",\\\"email\\\":\\\"joao@exemplo.com\\\",\\\"avatarUrl\\\":\\\"https://exemplo.com/avatar.jpg\\\",\\\"provider\\\":\\\"google\\\",\\\"providerId\\\":\\\"abc123\\\",\\\"openAiApiKey\\\":\\\"sk-...\\\",\\\"openAiModel\\\":\\\"gpt-4\\\",\\\"dataSources\\\":[{\\\"type\\\":\\\"rss\\\",\\\"url\\\":\\\"https://martinfowler.com/feed.atom\\\",\\\"tags\\\":[\\\"architecture\\\",\\\"ai\\\"]}],\\\"createdAt\\\":\\\"2024-01-01T00:00:00Z\\\",\\\"updatedAt\\\":\\\"2024-01-01T00:00:00Z\\\"}",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/models.User"
+                            "$ref": "#/definitions/models.BlogConnection"
                         }
                     },
                     "400": {
@@ -686,8 +841,8 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "422": {
+                        "description": "Unprocessable Entity",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -703,14 +858,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/posts": {
-            "get": {
+        "/blogs/{id}": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Retorna os posts gerados pelo usuário",
+                "description": "Replaces the site URL and credentials of a connected blog, checking them against the site again",
                 "consumes": [
                     "application/json"
                 ],
@@ -718,17 +873,100 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "Blogs"
+                ],
+                "summary": "Update a connected blog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog connection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blog site and credentials",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.UpdateBlogConnectionRequest"
+                        }
+                    }
                 ],
-                "summary": "List user posts",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/models.PostGenerationLog"
-                            }
+                            "$ref": "#/definitions/models.BlogConnection"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Removes a connected blog and its credentials. Posts already published to it are kept.",
+                "tags": [
+                    "Blogs"
+                ],
+                "summary": "Disconnect a blog",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog connection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "401": {
@@ -737,18 +975,32 @@ const docTemplate = `{
                             "type": "object",
                             "additionalProperties": true
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     }
                 }
             }
         },
-        "/posts/generate": {
+        "/blogs/{id}/posts": {
             "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Gera sugestão de post a partir de um tema/artigo usando OpenAI",
+                "description": "Creates a post on a WordPress or Ghost site, as a draft unless published is set, with tags and a feature image. The content is plain text split into paragraphs, or HTML with contentFormat=html; without content, the output of the generated post postLogId is used. The post is recorded as a story whose network is the blog platform. With the approval workflow enabled, postLogId must be an approved post and content, when given, its approved text.",
                 "consumes": [
                     "application/json"
                 ],
@@ -756,25 +1008,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Posts"
+                    "Blogs"
                 ],
-                "summary": "Generate post suggestion using OpenAI",
+                "summary": "Publish an article to a connected blog",
                 "parameters": [
                     {
-                        "description": "Dados para geração do post",
+                        "type": "string",
+                        "description": "Blog connection ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Article",
                         "name": "input",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/app.generatePostRequest"
+                            "$ref": "#/definitions/app.BlogPostRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/app.generatePostResponse"
+                            "$ref": "#/definitions/models.SocialPostStories"
                         }
                     },
                     "400": {
@@ -791,6 +1050,27 @@ const docTemplate = `{
                             "additionalProperties": true
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -800,350 +1080,5470 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "app.DuckArticle": {
-            "type": "object",
-            "properties": {
-                "publishedAt": {
-                    "type": "string"
-                },
-                "source": {
-                    "type": "string"
-                },
-                "summary": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "url": {
-                    "type": "string"
+        },
+        "/calendar/token": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a secret iCalendar feed URL with the user's scheduled and published posts, to subscribe to in Google Calendar or Outlook. Creating a new URL revokes the previous one. The token is only shown once.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Create the calendar feed URL",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/app.calendarTokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Revokes the user's iCalendar feed URL; subscribed calendars stop updating",
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "Revoke the calendar feed URL",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
                 }
             }
         },
-        "app.DuckArticlesResponse": {
-            "type": "object",
-            "properties": {
-                "articles": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/app.DuckArticle"
+        "/calendar/{token}.ics": {
+            "get": {
+                "description": "Public iCalendar feed of the user the token belongs to: scheduled posts as tentative events and published posts as confirmed events with a link to the post. Authenticated by the secret token in the URL instead of a JWT.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Calendar"
+                ],
+                "summary": "iCalendar feed of posts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Calendar feed token, optionally followed by .ics",
+                        "name": "token",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
                     }
                 }
             }
         },
-        "app.generatePostRequest": {
-            "type": "object",
-            "properties": {
+        "/comments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns comments left on the user's published posts, newest first. Pages are read with the nextCursor of the previous page.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "List the comment inbox",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by status (new, read, replying, replied)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max comments (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "nextCursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.CommentPage"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Mark a comment as new or read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.UpdateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostComment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/reply": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Posts the approved reply back to the comment through the network API",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Reply to a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reply text",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.ReplyCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostComment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/suggestions": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Uses the configured OpenAI model with the post and the comment as context",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Comments"
+                ],
+                "summary": "Generate reply suggestions for a comment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostComment"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/devto/articles": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an article on the user's dev.to account with the API key saved in the profile. Without published the article is saved as a dev.to draft. With the approval workflow enabled, postLogId must be an approved post and bodyMarkdown its approved text. The article is recorded as a story with network devto and the dev.to article ID as externalPostId.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DevTo"
+                ],
+                "summary": "Cross-post an article to dev.to",
+                "parameters": [
+                    {
+                        "description": "Article",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.DevToArticleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.SocialPostStories"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/devto/articles/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replaces the title, body, tags, canonical URL and published flag of an article created through POST /devto/articles. The previous body is kept as a revision of the story. With the approval workflow enabled, the new body must be the approved text of postLogId, or of the post the article came from.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "DevTo"
+                ],
+                "summary": "Update a dev.to article",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Social post story ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Article",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.DevToArticleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SocialPostStories"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/drafts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user's drafts, most recently edited first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "List drafts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by status (draft, scheduled, publishing, published)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max drafts (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Draft"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates an editable draft, optionally linked to a generated post and its source article. Without text, the generated output is used.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "Create a draft",
+                "parameters": [
+                    {
+                        "description": "Draft content",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.CreateDraftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.Draft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/drafts/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "Get a draft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Draft ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Draft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "Delete a draft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Draft ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes only the fields sent, which makes it suitable for autosave. The version must match the stored one, otherwise 409 is returned and the client should reload the draft.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "Update a draft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Draft ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change and the version being edited",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.UpdateDraftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Draft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/drafts/{id}/parts/{index}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the title and/or text of a single part, identified by its zero-based position. An empty title removes it. The version must match the stored one, otherwise 409 is returned; text that does not fit on a carousel slide returns 422.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "Update one part of a multi-part draft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Draft ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Part position, starting at 0",
+                        "name": "index",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change and the version being edited",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.UpdateDraftPartRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Draft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/drafts/{id}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publishes the current text of the draft to each target network, like /posts/{logId}/publish. The draft is marked as published when at least one target succeeds.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "Publish a draft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Draft ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target networks",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.PublishPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.CrossPostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/drafts/{id}/schedule": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Schedules the draft to be published to the target networks at a given time, or at the next recommended slot (see /insights/best-times) with slot=next_recommended. The draft can still be edited until then. If publishing fails, the draft goes back to the draft status with the reason in scheduleError. With the approval workflow enabled, only drafts of an approved post with its approved text can be scheduled, and the post moves to the scheduled review status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "Schedule a draft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Draft ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Time or slot, target networks and the version being edited",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.ScheduleDraftRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Draft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Turns a scheduled draft back into a plain draft, and its post back to the approved review status. Drafts that are not scheduled are returned unchanged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Drafts"
+                ],
+                "summary": "Cancel the schedule of a draft",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Draft ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Version being edited",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.DraftVersionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Draft"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/insights/best-times": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Ranks the weekday/hour slots of the user's published posts by engagement, in the given time zone. Accounts with fewer than 5 posts with metrics get default slots (source=default). nextSlot is the next upcoming recommended slot, which drafts can be scheduled to with slot=next_recommended.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Insights"
+                ],
+                "summary": "Recommended times to post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "IANA time zone, e.g. America/Sao_Paulo (default UTC)",
+                        "name": "timeZone",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.BestTimesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/l/{code}": {
+            "get": {
+                "description": "Redirects to the target of a short link created when a post was published, recording the click with its referrer. Clicks are counted in the metrics of the post.",
+                "tags": [
+                    "Links"
+                ],
+                "summary": "Follow a short link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Short link code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Found"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/linkedin/post/{postLogId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes a post from LinkedIn for the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LinkedIn"
+                ],
+                "summary": "Delete a post from LinkedIn",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post generation log ID",
+                        "name": "postLogId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exemplo: {\\\"status\\\": \\\"deleted\\\"}",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Exemplo: {\\\"error\\\": \\\"Invalid post ID\\\"}",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Exemplo: {\\\"error\\\": \\\"Unauthorized\\\"}",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Exemplo: {\\\"error\\\": \\\"Post not found\\\"}",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Exemplo: {\\\"error\\\": \\\"Failed to delete post\\\"}",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/linkedin/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publishes a post on LinkedIn for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "LinkedIn"
+                ],
+                "summary": "Publish a post on LinkedIn",
+                "parameters": [
+                    {
+                        "description": "Post content",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.PublishLinkedInPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exemplo: {\\\"status\\\": \\\"published\\\", \\\"linkedinPostId\\\": \\\"urn:li:share:...\\\" }",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Exemplo: {\\\"error\\\": \\\"Missing text\\\" }",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Exemplo: {\\\"error\\\": \\\"Unauthorized\\\" }",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Exemplo: {\\\"error\\\": \\\"post must be approved before it can be published\\\" }",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Exemplo: {\\\"error\\\": \\\"Failed to publish on LinkedIn\\\" }",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/me": {
+            "get": {
+                "description": "Returns the full user object for the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Get authenticated user profile",
+                "responses": {
+                    "200": {
+                        "description": "Exemplo de resposta: {\\\"id\\\":\\\"123\\\",\\\"name\\\":\\\"João Silva\\\",\\\"email\\\":\\\"joao@exemplo.com\\\",\\\"avatarUrl\\\":\\\"https://exemplo.com/avatar.jpg\\\",\\\"provider\\\":\\\"google\\\",\\\"providerId\\\":\\\"abc123\\\",\\\"openAiApiKey\\\":\\\"sk-...\\\",\\\"openAiModel\\\":\\\"gpt-4\\\",\\\"dataSources\\\":[{\\\"type\\\":\\\"rss\\\",\\\"url\\\":\\\"https://martinfowler.com/feed.atom\\\",\\\"tags\\\":[\\\"architecture\\\",\\\"ai\\\"]}],\\\"createdAt\\\":\\\"2024-01-01T00:00:00Z\\\",\\\"updatedAt\\\":\\\"2024-01-01T00:00:00Z\\\"}",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update OpenAI, the dev.to API key and data sources for the authenticated user. An empty devToApiKey keeps the saved key; DELETE /auth/devto/disconnect removes it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "Update user profile/configuration",
+                "parameters": [
+                    {
+                        "description": "Profile update info",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exemplo de resposta: {\\\"id\\\":\\\"123\\\",\\\"name\\\":\\\"João Silva\\\",\\\"email\\\":\\\"joao@exemplo.com\\\",\\\"avatarUrl\\\":\\\"https://exemplo.com/avatar.jpg\\\",\\\"provider\\\":\\\"google\\\",\\\"providerId\\\":\\\"abc123\\\",\\\"openAiApiKey\\\":\\\"sk-...\\\",\\\"openAiModel\\\":\\\"gpt-4\\\",\\\"dataSources\\\":[{\\\"type\\\":\\\"rss\\\",\\\"url\\\":\\\"https://martinfowler.com/feed.atom\\\",\\\"tags\\\":[\\\"architecture\\\",\\\"ai\\\"]}],\\\"createdAt\\\":\\\"2024-01-01T00:00:00Z\\\",\\\"updatedAt\\\":\\\"2024-01-01T00:00:00Z\\\"}",
+                        "schema": {
+                            "$ref": "#/definitions/models.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "List notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max notifications (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Notification"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "tags": [
+                    "Notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/posts": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Retorna os posts gerados pelo usuário",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "List user posts",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PostGenerationLog"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/generate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Gera sugestão de post a partir de um tema/artigo usando OpenAI. Com \"parts\", gera um post em partes (thread ou carrossel).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Generate post suggestion using OpenAI",
+                "parameters": [
+                    {
+                        "description": "Dados para geração do post",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.GeneratePostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/app.generatePostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Imports posts from a CSV file with a header row or a JSON array, with the columns text, network, scheduledAt (RFC 3339, or YYYY-MM-DD HH:MM in timeZone), link and imageUrl. The link is appended to the text when it is not in it yet. Images are not published yet, so the imageUrl of a row is ignored with a warning in the row results. With dryRun=true the rows are only validated. Otherwise every row becomes a draft, scheduled when it has a time, in a single batch; if any row is invalid nothing is imported and 422 is returned with the per-row errors.",
+                "consumes": [
+                    "text/csv",
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Bulk import posts",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only validate the rows",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA time zone of scheduled times without an offset (default UTC)",
+                        "name": "timeZone",
+                        "in": "query"
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV or JSON file, when sent as multipart",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Dry run results",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostImport"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostImport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostImport"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/imports": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the user's committed imports, newest first, without their per-row results",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "List post imports",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Max imports (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PostImport"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/imports/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns a committed import with the result of each row and the draft created for it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Get a post import",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostImport"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/preview": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Renders the text as each network shows it: where LinkedIn folds it behind \"ver mais\", how hashtags and mentions render, where the link card goes and the character count under the network's rules (X counts links as 23 and CJK/emoji as 2). Each preview includes an HTML snippet that can be embedded as is.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Preview a post on each network",
+                "parameters": [
+                    {
+                        "description": "Text and networks (default: all)",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.PreviewPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/app.previewPostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{logId}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Publishes a generated post to each target network, with optional per-target text. A failure on one target does not abort the others.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Posts"
+                ],
+                "summary": "Cross-post a generated post to several networks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post generation log ID",
+                        "name": "logId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target networks",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.PublishPostRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.CrossPostResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/posts/{logId}/review": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Authors submit posts for review; reviewers approve them or request changes with a comment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "Move a post through the approval workflow",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Post generation log ID",
+                        "name": "logId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Review action",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.ReviewActionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.PostGenerationLog"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/review/authors/{id}/disable": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Lets one of the author's reviewers turn off their approval workflow. The reviewers stay assigned and the author is notified.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "Disable the approval workflow of an author",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Author user ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/review/queue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "List posts waiting for my review",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Max posts (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.PostGenerationLog"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/review/settings": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Enables the approval workflow and assigns the reviewers of the user's posts. Once enabled, only a reviewer can disable it, through POST /review/authors/{id}/disable.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Review"
+                ],
+                "summary": "Configure the approval workflow",
+                "parameters": [
+                    {
+                        "description": "Workflow settings",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.UpdateReviewSettingsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.ReviewSettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/sources/types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the data source types that can be added to the user's profile and the configuration each one takes",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Articles"
+                ],
+                "summary": "List data source types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/services.ArticleSourceType"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/metrics/summary": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns engagement totals, short link clicks, average engagement per post and the top performing posts",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stories"
+                ],
+                "summary": "Get engagement summary of the user's published posts",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.MetricsSummary"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/{id}": {
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Updates the text of a published LinkedIn post and keeps the previous text as a revision",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stories"
+                ],
+                "summary": "Edit a published post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Social post story ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New post text",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.EditStoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SocialPostStories"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/{id}/evergreen": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sets the rules to reshare a published post: minimum days between reshares, maximum number of reshares and whether the text is reworded by AI each time. A background job queues each reshare as a draft scheduled at the next recommended slot; the published reshare is recorded as a new story with originalStoryId set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stories"
+                ],
+                "summary": "Mark a published post as evergreen",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Social post story ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Evergreen rules",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.EvergreenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.SocialPostStories"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/{id}/metrics": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the latest reactions, comments and shares of a published post and their time series, along with its short links and their clicks",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stories"
+                ],
+                "summary": "Get engagement metrics of a published post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Social post story ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.StoryMetricsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/{id}/reshares": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the stories published as reshares of an evergreen post, oldest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stories"
+                ],
+                "summary": "List reshares of an evergreen post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Social post story ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.SocialPostStories"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/stories/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns every version of a published post's text with a word diff against the previous version",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stories"
+                ],
+                "summary": "List revisions of a published post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Social post story ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/services.StoryRevisionsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook endpoints",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.Webhook"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Registers a URL that receives post and account events as signed JSON. The X-PostPilot-Signature header carries \"sha256=\" followed by the hex HMAC-SHA256 of \"{X-PostPilot-Timestamp}.{body}\", keyed with the secret returned here only once. Without events, every event is sent. Only http(s) URLs of public hosts are accepted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Register a webhook endpoint",
+                "parameters": [
+                    {
+                        "description": "Webhook endpoint",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/app.webhookCreatedResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Deletes the endpoint and its delivery log",
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Changes the URL, description, event filter or active flag. Only the fields sent are changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "input",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/app.UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.Webhook"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Returns the events sent to the endpoint, newest first, with attempts, last response status and next retry",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List the deliveries of a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Max deliveries (default 50, max 200)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.WebhookDelivery"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/test": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Sends a signed webhook.test event right away and returns the delivery with the response status. Test events are not retried.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Send a test event to a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.WebhookDelivery"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "app.BlogConnectionRequest": {
+            "type": "object",
+            "required": [
+                "platform",
+                "siteUrl"
+            ],
+            "properties": {
+                "adminApiKey": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "applicationPassword": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "platform": {
+                    "type": "string",
+                    "enum": [
+                        "wordpress",
+                        "ghost"
+                    ]
+                },
+                "siteUrl": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "app.BlogPostRequest": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string",
+                    "maxLength": 200000
+                },
+                "contentFormat": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "html"
+                    ]
+                },
+                "featureImageUrl": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "postLogId": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "app.CreateDraftRequest": {
+            "type": "object",
+            "properties": {
+                "article": {
+                    "$ref": "#/definitions/app.DraftArticleRequest"
+                },
+                "parts": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/app.PostPartRequest"
+                    }
+                },
+                "postLogId": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 3000
+                },
+                "theme": {
+                    "type": "string",
+                    "enum": [
+                        "light",
+                        "dark",
+                        "ocean"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "app.CreateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "events": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "app.DataSourceRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "listing": {
+                    "description": "Listing, Window, MinScore and SkipSelfPosts configure reddit sources",
+                    "type": "string",
+                    "enum": [
+                        "hot",
+                        "top",
+                        "new"
+                    ]
+                },
+                "minScore": {
+                    "type": "integer",
+                    "maximum": 100000,
+                    "minimum": 0
+                },
+                "skipSelfPosts": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "window": {
+                    "type": "string",
+                    "enum": [
+                        "hour",
+                        "day",
+                        "week",
+                        "month",
+                        "year",
+                        "all"
+                    ]
+                }
+            }
+        },
+        "app.DevToArticleRequest": {
+            "type": "object",
+            "required": [
+                "bodyMarkdown",
+                "title"
+            ],
+            "properties": {
+                "bodyMarkdown": {
+                    "type": "string",
+                    "maxLength": 100000,
+                    "minLength": 1
+                },
+                "canonicalUrl": {
+                    "type": "string"
+                },
+                "postLogId": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 4,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 1
+                }
+            }
+        },
+        "app.DraftArticleRequest": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "title": {
+                    "type": "string",
+                    "maxLength": 300
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.DraftVersionRequest": {
+            "type": "object",
+            "properties": {
+                "version": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "app.DuckArticle": {
+            "type": "object",
+            "properties": {
+                "publishedAt": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.DuckArticlesResponse": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/app.DuckArticle"
+                    }
+                }
+            }
+        },
+        "app.EditStoryRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "type": "string",
+                    "maxLength": 3000,
+                    "minLength": 1
+                }
+            }
+        },
+        "app.EvergreenRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "maxRepetitions": {
+                    "type": "integer",
+                    "maximum": 52,
+                    "minimum": 1
+                },
+                "minDaysBetween": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 1
+                },
+                "reword": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "app.GeneratePostRequest": {
+            "type": "object",
+            "required": [
+                "topic"
+            ],
+            "properties": {
+                "parts": {
+                    "type": "integer",
+                    "maximum": 10,
+                    "minimum": 2
+                },
                 "topic": {
+                    "type": "string",
+                    "maxLength": 2000,
+                    "minLength": 3
+                }
+            }
+        },
+        "app.LoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.PostPartRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 120
+                }
+            }
+        },
+        "app.PreviewPostRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "networks": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 10000,
+                    "minLength": 1
+                }
+            }
+        },
+        "app.PublishLinkedInPostRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "postLogId": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 3000,
+                    "minLength": 1
+                }
+            }
+        },
+        "app.PublishPostRequest": {
+            "type": "object",
+            "required": [
+                "targets"
+            ],
+            "properties": {
+                "targets": {
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/app.PublishTargetRequest"
+                    }
+                }
+            }
+        },
+        "app.PublishTargetRequest": {
+            "type": "object",
+            "required": [
+                "network"
+            ],
+            "properties": {
+                "campaign": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "network": {
+                    "type": "string",
+                    "enum": [
+                        "linkedin"
+                    ]
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 3000
+                }
+            }
+        },
+        "app.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.RegisterRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 2
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 8
+                }
+            }
+        },
+        "app.ReplyCommentRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "type": "string",
+                    "maxLength": 1250,
+                    "minLength": 1
+                }
+            }
+        },
+        "app.ReviewActionRequest": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "submit",
+                        "approve",
+                        "request_changes"
+                    ]
+                },
+                "comment": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "app.ScheduleDraftRequest": {
+            "type": "object",
+            "required": [
+                "targets"
+            ],
+            "properties": {
+                "at": {
+                    "type": "string"
+                },
+                "slot": {
+                    "type": "string",
+                    "enum": [
+                        "next_recommended"
+                    ]
+                },
+                "targets": {
+                    "type": "array",
+                    "minItems": 1,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/app.PublishTargetRequest"
+                    }
+                },
+                "timeZone": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "app.SocialLoginRequest": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "provider",
+                "providerId"
+            ],
+            "properties": {
+                "avatarUrl": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "google",
+                        "linkedin"
+                    ]
+                },
+                "providerId": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.UpdateBlogConnectionRequest": {
+            "type": "object",
+            "required": [
+                "siteUrl"
+            ],
+            "properties": {
+                "adminApiKey": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "applicationPassword": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "siteUrl": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 200
+                }
+            }
+        },
+        "app.UpdateCommentRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "new",
+                        "read"
+                    ]
+                }
+            }
+        },
+        "app.UpdateDraftPartRequest": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string",
+                    "maxLength": 1000,
+                    "minLength": 1
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 120
+                },
+                "version": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "app.UpdateDraftRequest": {
+            "type": "object",
+            "properties": {
+                "parts": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "$ref": "#/definitions/app.PostPartRequest"
+                    }
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 3000
+                },
+                "theme": {
+                    "type": "string",
+                    "enum": [
+                        "light",
+                        "dark",
+                        "ocean"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "version": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "app.UpdateProfileRequest": {
+            "type": "object",
+            "properties": {
+                "dataSources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/app.DataSourceRequest"
+                    }
+                },
+                "devToApiKey": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "openAiApiKey": {
+                    "type": "string"
+                },
+                "openAiModel": {
+                    "type": "string",
+                    "enum": [
+                        "gpt-3.5-turbo",
+                        "gpt-4",
+                        "gpt-4-turbo",
+                        "gpt-4o"
+                    ]
+                }
+            }
+        },
+        "app.UpdateReviewSettingsRequest": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "reviewerEmails": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "app.UpdateWebhookRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "events": {
+                    "type": "array",
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "app.calendarTokenResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "app.generatePostResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "generatedText": {
+                    "type": "string"
+                },
+                "logId": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostPart"
+                    }
+                },
+                "usage": {
+                    "type": "object",
+                    "additionalProperties": true
+                }
+            }
+        },
+        "app.loginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "user": {
+                    "$ref": "#/definitions/models.User"
+                }
+            }
+        },
+        "app.previewPostResponse": {
+            "type": "object",
+            "properties": {
+                "previews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.NetworkPreview"
+                    }
+                }
+            }
+        },
+        "app.refreshResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                }
+            }
+        },
+        "app.webhookCreatedResponse": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WebhookEvent"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.AuthProvider": {
+            "type": "string",
+            "enum": [
+                "local",
+                "google",
+                "linkedin"
+            ],
+            "x-enum-varnames": [
+                "AuthProviderLocal",
+                "AuthProviderGoogle",
+                "AuthProviderLinkedIn"
+            ]
+        },
+        "models.BlogConnection": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "platform": {
+                    "$ref": "#/definitions/models.BlogPlatform"
+                },
+                "siteName": {
+                    "type": "string"
+                },
+                "siteUrl": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                },
+                "verifiedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.BlogPlatform": {
+            "type": "string",
+            "enum": [
+                "wordpress",
+                "ghost"
+            ],
+            "x-enum-varnames": [
+                "BlogPlatformWordPress",
+                "BlogPlatformGhost"
+            ]
+        },
+        "models.CommentStatus": {
+            "type": "string",
+            "enum": [
+                "new",
+                "read",
+                "replying",
+                "replied"
+            ],
+            "x-enum-comments": {
+                "CommentStatusReplying": "a reply is being sent"
+            },
+            "x-enum-varnames": [
+                "CommentStatusNew",
+                "CommentStatusRead",
+                "CommentStatusReplying",
+                "CommentStatusReplied"
+            ]
+        },
+        "models.DataSource": {
+            "type": "object",
+            "properties": {
+                "listing": {
+                    "description": "Options read by some source types only, as listed by GET /sources/types",
+                    "type": "string",
+                    "example": "top"
+                },
+                "minScore": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "skipSelfPosts": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "$ref": "#/definitions/models.DataSourceType"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "window": {
+                    "type": "string",
+                    "example": "week"
+                }
+            }
+        },
+        "models.DataSourceType": {
+            "type": "string",
+            "enum": [
+                "rss",
+                "devto",
+                "hackernews",
+                "reddit"
+            ],
+            "x-enum-varnames": [
+                "DataSourceRSS",
+                "DataSourceDevTo",
+                "DataSourceHackerNews",
+                "DataSourceReddit"
+            ]
+        },
+        "models.Draft": {
+            "type": "object",
+            "properties": {
+                "article": {
+                    "$ref": "#/definitions/models.DraftArticle"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "evergreenStoryId": {
+                    "description": "set on reshares of evergreen posts",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "importId": {
+                    "type": "string"
+                },
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostPart"
+                    }
+                },
+                "postGenerationLogId": {
+                    "type": "string"
+                },
+                "publishedAt": {
+                    "type": "string"
+                },
+                "scheduleError": {
+                    "type": "string"
+                },
+                "scheduleTargets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DraftTarget"
+                    }
+                },
+                "scheduledAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.DraftStatus"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "theme": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DraftArticle": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.DraftStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "scheduled",
+                "publishing",
+                "published"
+            ],
+            "x-enum-varnames": [
+                "DraftStatusDraft",
+                "DraftStatusScheduled",
+                "DraftStatusPublishing",
+                "DraftStatusPublished"
+            ]
+        },
+        "models.DraftTarget": {
+            "type": "object",
+            "properties": {
+                "campaign": {
+                    "type": "string"
+                },
+                "network": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.EngagementMetrics": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "integer"
+                },
+                "impressions": {
+                    "type": "integer"
+                },
+                "reactions": {
+                    "type": "integer"
+                },
+                "shares": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.EvergreenSettings": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "lastQueuedAt": {
+                    "type": "string"
+                },
+                "maxRepetitions": {
+                    "type": "integer"
+                },
+                "minDaysBetween": {
+                    "type": "integer"
+                },
+                "nextEligibleAt": {
+                    "type": "string"
+                },
+                "reshares": {
+                    "type": "integer"
+                },
+                "reword": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "models.ImportFormat": {
+            "type": "string",
+            "enum": [
+                "csv",
+                "json"
+            ],
+            "x-enum-varnames": [
+                "ImportFormatCSV",
+                "ImportFormatJSON"
+            ]
+        },
+        "models.ImportRowResult": {
+            "type": "object",
+            "properties": {
+                "draftId": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "network": {
+                    "type": "string"
+                },
+                "row": {
+                    "type": "integer"
+                },
+                "scheduledAt": {
+                    "type": "string"
+                },
+                "valid": {
+                    "type": "boolean"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.LinkedInConnectionStatus": {
+            "type": "string",
+            "enum": [
+                "connected",
+                "expiring_soon",
+                "needs_reconnect"
+            ],
+            "x-enum-varnames": [
+                "LinkedInConnected",
+                "LinkedInExpiringSoon",
+                "LinkedInNeedsReconnect"
+            ]
+        },
+        "models.Notification": {
+            "type": "object",
+            "properties": {
+                "actorId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "postGenerationLogId": {
+                    "type": "string"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "$ref": "#/definitions/models.NotificationType"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.NotificationType": {
+            "type": "string",
+            "enum": [
+                "review_requested",
+                "changes_requested",
+                "post_approved",
+                "review_disabled"
+            ],
+            "x-enum-varnames": [
+                "NotificationReviewRequested",
+                "NotificationChangesRequested",
+                "NotificationPostApproved",
+                "NotificationReviewDisabled"
+            ]
+        },
+        "models.PostComment": {
+            "type": "object",
+            "properties": {
+                "authorUrn": {
+                    "type": "string"
+                },
+                "commentedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "externalCommentId": {
+                    "type": "string"
+                },
+                "externalPostId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "network": {
+                    "type": "string"
+                },
+                "repliedAt": {
+                    "type": "string"
+                },
+                "replyExternalId": {
+                    "type": "string"
+                },
+                "replySuggestions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "replyText": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.CommentStatus"
+                },
+                "storyId": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PostFormat": {
+            "type": "string",
+            "enum": [
+                "text",
+                "document"
+            ],
+            "x-enum-varnames": [
+                "PostFormatText",
+                "PostFormatDocument"
+            ]
+        },
+        "models.PostGenerationLog": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "input": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "output": {
+                    "type": "string"
+                },
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostPart"
+                    }
+                },
+                "publishedAt": {
+                    "type": "string"
+                },
+                "reviewHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ReviewEvent"
+                    }
+                },
+                "reviewStatus": {
+                    "$ref": "#/definitions/models.ReviewStatus"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.PostStatus"
+                },
+                "statusHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatusTransition"
+                    }
+                },
+                "usage": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PostImport": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "draftsCreated": {
+                    "type": "integer"
+                },
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "format": {
+                    "$ref": "#/definitions/models.ImportFormat"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ImportRowResult"
+                    }
+                },
+                "scheduledPosts": {
+                    "type": "integer"
+                },
+                "totalRows": {
+                    "type": "integer"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "validRows": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.PostMetricsSnapshot": {
+            "type": "object",
+            "properties": {
+                "collectedAt": {
+                    "type": "string"
+                },
+                "comments": {
+                    "type": "integer"
+                },
+                "externalPostId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "impressions": {
+                    "type": "integer"
+                },
+                "network": {
+                    "type": "string"
+                },
+                "reactions": {
+                    "type": "integer"
+                },
+                "shares": {
+                    "type": "integer"
+                },
+                "storyId": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PostPart": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PostRevision": {
+            "type": "object",
+            "properties": {
+                "liveFrom": {
+                    "type": "string"
+                },
+                "replacedAt": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.PostStatus": {
+            "type": "string",
+            "enum": [
+                "started",
+                "success",
+                "error",
+                "published",
+                "partially_published",
+                "deleted"
+            ],
+            "x-enum-comments": {
+                "PostStatusSuccess": "generated, not published yet"
+            },
+            "x-enum-varnames": [
+                "PostStatusStarted",
+                "PostStatusSuccess",
+                "PostStatusError",
+                "PostStatusPublished",
+                "PostStatusPartiallyPublished",
+                "PostStatusDeleted"
+            ]
+        },
+        "models.ReviewEvent": {
+            "type": "object",
+            "properties": {
+                "actorId": {
+                    "type": "string"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "from": {
+                    "$ref": "#/definitions/models.ReviewStatus"
+                },
+                "to": {
+                    "$ref": "#/definitions/models.ReviewStatus"
+                }
+            }
+        },
+        "models.ReviewSettings": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "reviewerIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "models.ReviewStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "in_review",
+                "changes_requested",
+                "approved",
+                "scheduled",
+                "published"
+            ],
+            "x-enum-varnames": [
+                "ReviewStatusDraft",
+                "ReviewStatusInReview",
+                "ReviewStatusChangesRequested",
+                "ReviewStatusApproved",
+                "ReviewStatusScheduled",
+                "ReviewStatusPublished"
+            ]
+        },
+        "models.ShortLink": {
+            "type": "object",
+            "properties": {
+                "campaign": {
+                    "type": "string"
+                },
+                "clicks": {
+                    "type": "integer"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastClickedAt": {
+                    "type": "string"
+                },
+                "network": {
+                    "type": "string"
+                },
+                "shortUrl": {
+                    "type": "string"
+                },
+                "storyId": {
+                    "type": "string"
+                },
+                "targetUrl": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.SocialPostStories": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "documentUrn": {
+                    "type": "string"
+                },
+                "draftId": {
+                    "type": "string"
+                },
+                "editedAt": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "evergreen": {
+                    "$ref": "#/definitions/models.EvergreenSettings"
+                },
+                "externalPostId": {
+                    "type": "string"
+                },
+                "externalUrl": {
+                    "type": "string"
+                },
+                "format": {
+                    "$ref": "#/definitions/models.PostFormat"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "latestMetrics": {
+                    "$ref": "#/definitions/models.EngagementMetrics"
+                },
+                "metricsSyncedAt": {
+                    "type": "string"
+                },
+                "network": {
+                    "description": "ex: linkedin, twitter",
+                    "type": "string"
+                },
+                "originalStoryId": {
+                    "type": "string"
+                },
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostPart"
+                    }
+                },
+                "payload": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "postContent": {
+                    "type": "string"
+                },
+                "postGenerationLogId": {
+                    "type": "string"
+                },
+                "response": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "revisions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostRevision"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/models.StoryStatus"
+                },
+                "statusHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.StatusTransition"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "app.generatePostResponse": {
+        "models.StatusTransition": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "actorId": {
                     "type": "string"
                 },
-                "generatedText": {
+                "at": {
                     "type": "string"
                 },
-                "logId": {
+                "from": {
                     "type": "string"
                 },
-                "model": {
+                "reason": {
                     "type": "string"
                 },
-                "usage": {
-                    "type": "object",
-                    "additionalProperties": true
+                "to": {
+                    "type": "string"
                 }
             }
         },
-        "app.loginRequest": {
+        "models.StoryStatus": {
+            "type": "string",
+            "enum": [
+                "started",
+                "success",
+                "error",
+                "deleted"
+            ],
+            "x-enum-varnames": [
+                "StoryStatusStarted",
+                "StoryStatusSuccess",
+                "StoryStatusError",
+                "StoryStatusDeleted"
+            ]
+        },
+        "models.User": {
             "type": "object",
             "properties": {
+                "avatarUrl": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "dataSources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataSource"
+                    }
+                },
+                "devToApiKey": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string",
                     "example": "john@example.com"
                 },
-                "password": {
+                "id": {
+                    "type": "string"
+                },
+                "lastLogin": {
+                    "type": "string",
+                    "example": "2024-01-01T00:00:00Z"
+                },
+                "linkedinAccessToken": {
+                    "type": "string"
+                },
+                "linkedinConnectionCheckedAt": {
+                    "type": "string"
+                },
+                "linkedinConnectionStatus": {
+                    "$ref": "#/definitions/models.LinkedInConnectionStatus"
+                },
+                "linkedinPersonUrn": {
+                    "type": "string"
+                },
+                "linkedinRefreshToken": {
+                    "type": "string"
+                },
+                "linkedinRefreshTokenExpiresAt": {
+                    "type": "string"
+                },
+                "linkedinTokenExpiresAt": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "John Doe"
+                },
+                "openAiApiKey": {
+                    "type": "string"
+                },
+                "openAiModel": {
+                    "type": "string"
+                },
+                "provider": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.AuthProvider"
+                        }
+                    ],
+                    "example": "local"
+                },
+                "providerId": {
+                    "type": "string",
+                    "example": "123456789"
+                },
+                "review": {
+                    "$ref": "#/definitions/models.ReviewSettings"
+                },
+                "updatedAt": {
                     "type": "string",
-                    "example": "123456"
+                    "example": "2024-01-01T00:00:00Z"
                 }
             }
         },
-        "app.loginResponse": {
+        "models.Webhook": {
             "type": "object",
             "properties": {
-                "token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                "active": {
+                    "type": "boolean"
                 },
-                "user": {
-                    "$ref": "#/definitions/models.User"
+                "createdAt": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.WebhookEvent"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
                 }
             }
         },
-        "app.publishLinkedInPostRequest": {
+        "models.WebhookDelivery": {
             "type": "object",
             "properties": {
-                "text": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "body": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deliveredAt": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/models.WebhookEvent"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "lastStatusCode": {
+                    "type": "integer"
+                },
+                "nextAttemptAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/models.WebhookDeliveryStatus"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "webhookId": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.WebhookDeliveryStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "succeeded",
+                "failed"
+            ],
+            "x-enum-varnames": [
+                "WebhookDeliveryPending",
+                "WebhookDeliverySucceeded",
+                "WebhookDeliveryFailed"
+            ]
+        },
+        "models.WebhookEvent": {
+            "type": "string",
+            "enum": [
+                "post.generated",
+                "post.published",
+                "post.failed",
+                "post.deleted",
+                "linkedin.connection_expired",
+                "webhook.test"
+            ],
+            "x-enum-varnames": [
+                "WebhookPostGenerated",
+                "WebhookPostPublished",
+                "WebhookPostFailed",
+                "WebhookPostDeleted",
+                "WebhookLinkedInConnectionExpired",
+                "WebhookTest"
+            ]
+        },
+        "services.ArticleSearchResult": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.MergedArticle"
+                    }
+                },
+                "nextCursor": {
                     "type": "string"
                 }
             }
         },
-        "app.refreshRequest": {
+        "services.ArticleSourceType": {
             "type": "object",
             "properties": {
-                "refreshToken": {
+                "description": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                    "example": "RSS / Atom"
+                },
+                "options": {
+                    "description": "Options are the other data source fields the type reads, like \"listing\" or \"minScore\"",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "supportsTags": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DataSourceType"
+                        }
+                    ],
+                    "example": "rss"
+                },
+                "urlRequired": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "services.ArticleSuggestions": {
+            "type": "object",
+            "properties": {
+                "articles": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.MergedArticle"
+                    }
+                },
+                "hasMore": {
+                    "type": "boolean"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "pendingSources": {
+                    "type": "integer"
+                },
+                "sourceErrors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.SourceError"
+                    }
+                }
+            }
+        },
+        "services.BestTimesResponse": {
+            "type": "object",
+            "properties": {
+                "nextSlot": {
+                    "type": "string"
+                },
+                "sampledPosts": {
+                    "type": "integer"
+                },
+                "slots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.TimeSlot"
+                    }
+                },
+                "source": {
+                    "description": "history or default",
+                    "type": "string"
+                },
+                "timeZone": {
+                    "type": "string"
+                }
+            }
+        },
+        "services.CommentPage": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.PostComment"
+                    }
+                },
+                "nextCursor": {
+                    "type": "string"
+                }
+            }
+        },
+        "services.CrossPostResponse": {
+            "type": "object",
+            "properties": {
+                "draftId": {
+                    "type": "string"
+                },
+                "postLogId": {
+                    "type": "string"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PublishTargetResult"
+                    }
+                },
+                "status": {
+                    "description": "published, partially_published, failed",
+                    "type": "string"
+                }
+            }
+        },
+        "services.DiffOp": {
+            "type": "object",
+            "properties": {
+                "op": {
+                    "description": "equal, insert, delete",
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
                 }
             }
         },
-        "app.refreshResponse": {
+        "services.LinkCardPreview": {
             "type": "object",
             "properties": {
-                "token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                "domain": {
+                    "type": "string"
+                },
+                "position": {
+                    "description": "below_text",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "app.registerRequest": {
+        "services.MergedArticle": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "john@example.com"
+                "canonicalUrl": {
+                    "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "John Doe"
+                "id": {
+                    "type": "string"
                 },
-                "password": {
-                    "type": "string",
-                    "example": "123456"
+                "publishedAt": {
+                    "type": "string"
+                },
+                "score": {
+                    "description": "text search relevance",
+                    "type": "number"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.MergedArticleSource"
+                    }
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "app.socialLoginRequest": {
+        "services.MergedArticleSource": {
             "type": "object",
             "properties": {
-                "avatarUrl": {
-                    "type": "string",
-                    "example": "https://example.com/avatar.jpg"
-                },
-                "email": {
-                    "type": "string",
-                    "example": "john@example.com"
-                },
                 "name": {
                     "type": "string",
-                    "example": "John Doe"
+                    "example": "Hacker News"
                 },
-                "provider": {
-                    "enum": [
-                        "google",
-                        "linkedin"
-                    ],
+                "type": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/models.AuthProvider"
+                            "$ref": "#/definitions/models.DataSourceType"
                         }
                     ],
-                    "example": "google"
+                    "example": "hackernews"
                 },
-                "providerId": {
-                    "type": "string",
-                    "example": "123456789"
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "app.updateProfileRequest": {
+        "services.MetricsSummary": {
             "type": "object",
             "properties": {
-                "dataSources": {
+                "averageEngagement": {
+                    "type": "number"
+                },
+                "clicks": {
+                    "type": "integer"
+                },
+                "lastSyncedAt": {
+                    "type": "string"
+                },
+                "topPosts": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/models.DataSource"
+                        "$ref": "#/definitions/services.PostEngagement"
                     }
                 },
-                "openAiApiKey": {
-                    "type": "string"
+                "totals": {
+                    "$ref": "#/definitions/models.EngagementMetrics"
                 },
-                "openAiModel": {
-                    "type": "string"
+                "trackedPosts": {
+                    "type": "integer"
                 }
             }
         },
-        "models.AuthProvider": {
-            "type": "string",
-            "enum": [
-                "local",
-                "google",
-                "linkedin"
-            ],
-            "x-enum-varnames": [
-                "AuthProviderLocal",
-                "AuthProviderGoogle",
-                "AuthProviderLinkedIn"
-            ]
-        },
-        "models.DataSource": {
+        "services.NetworkPreview": {
             "type": "object",
             "properties": {
-                "tags": {
+                "characterCount": {
+                    "type": "integer"
+                },
+                "characterLimit": {
+                    "type": "integer"
+                },
+                "hashtags": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "type": {
-                    "$ref": "#/definitions/models.DataSourceType"
+                "html": {
+                    "type": "string"
                 },
-                "url": {
+                "linkCard": {
+                    "$ref": "#/definitions/services.LinkCardPreview"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mentions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mentionsLinked": {
+                    "type": "boolean"
+                },
+                "network": {
+                    "type": "string"
+                },
+                "segments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.PreviewSegment"
+                    }
+                },
+                "truncated": {
+                    "type": "boolean"
+                },
+                "visibleText": {
                     "type": "string"
+                },
+                "withinLimit": {
+                    "type": "boolean"
                 }
             }
         },
-        "models.DataSourceType": {
-            "type": "string",
-            "enum": [
-                "rss",
-                "devto",
-                "hackernews"
-            ],
-            "x-enum-varnames": [
-                "DataSourceRSS",
-                "DataSourceDevTo",
-                "DataSourceHackerNews"
-            ]
-        },
-        "models.PostGenerationLog": {
+        "services.PostEngagement": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string"
+                "clicks": {
+                    "type": "integer"
                 },
-                "error": {
-                    "type": "string"
+                "metrics": {
+                    "$ref": "#/definitions/models.EngagementMetrics"
                 },
-                "id": {
+                "network": {
                     "type": "string"
                 },
-                "input": {
+                "postContent": {
                     "type": "string"
                 },
-                "model": {
+                "publishedAt": {
                     "type": "string"
                 },
-                "output": {
+                "storyId": {
                     "type": "string"
-                },
-                "status": {
+                }
+            }
+        },
+        "services.PreviewSegment": {
+            "type": "object",
+            "properties": {
+                "href": {
                     "type": "string"
                 },
-                "usage": {
-                    "type": "object",
-                    "additionalProperties": true
+                "text": {
+                    "type": "string"
                 },
-                "userId": {
+                "type": {
+                    "description": "text, hashtag, mention, link",
                     "type": "string"
                 }
             }
         },
-        "models.User": {
+        "services.PublishTargetResult": {
             "type": "object",
             "properties": {
-                "avatarUrl": {
-                    "type": "string",
-                    "example": "https://example.com/avatar.jpg"
-                },
-                "createdAt": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
-                },
-                "dataSources": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/models.DataSource"
-                    }
-                },
-                "email": {
-                    "type": "string",
-                    "example": "john@example.com"
-                },
-                "id": {
+                "error": {
                     "type": "string"
                 },
-                "lastLogin": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
-                },
-                "linkedinAccessToken": {
+                "externalPostId": {
                     "type": "string"
                 },
-                "linkedinPersonUrn": {
+                "network": {
                     "type": "string"
                 },
-                "linkedinRefreshToken": {
+                "status": {
+                    "description": "success, error",
                     "type": "string"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "John Doe"
-                },
-                "openAiApiKey": {
+                "storyId": {
                     "type": "string"
-                },
-                "openAiModel": {
+                }
+            }
+        },
+        "services.SourceError": {
+            "type": "object",
+            "properties": {
+                "error": {
                     "type": "string"
                 },
-                "provider": {
+                "type": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/models.AuthProvider"
+                            "$ref": "#/definitions/models.DataSourceType"
                         }
                     ],
-                    "example": "local"
-                },
-                "providerId": {
-                    "type": "string",
-                    "example": "123456789"
+                    "example": "rss"
                 },
-                "updatedAt": {
-                    "type": "string",
-                    "example": "2024-01-01T00:00:00Z"
+                "url": {
+                    "type": "string"
                 }
             }
         },
-        "services.Article": {
+        "services.StoryMetricsResponse": {
             "type": "object",
             "properties": {
-                "publishedAt": {
+                "clicks": {
+                    "type": "integer"
+                },
+                "externalPostId": {
                     "type": "string"
                 },
-                "source": {
+                "latest": {
+                    "$ref": "#/definitions/models.EngagementMetrics"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.ShortLink"
+                    }
+                },
+                "metricsSyncedAt": {
                     "type": "string"
                 },
-                "summary": {
+                "network": {
                     "type": "string"
                 },
-                "tags": {
+                "series": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/models.PostMetricsSnapshot"
                     }
                 },
-                "title": {
+                "storyId": {
+                    "type": "string"
+                }
+            }
+        },
+        "services.StoryRevisionsResponse": {
+            "type": "object",
+            "properties": {
+                "storyId": {
                     "type": "string"
                 },
-                "url": {
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.StoryVersion"
+                    }
+                }
+            }
+        },
+        "services.StoryVersion": {
+            "type": "object",
+            "properties": {
+                "current": {
+                    "type": "boolean"
+                },
+                "diff": {
+                    "description": "changes from the previous version",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/services.DiffOp"
+                    }
+                },
+                "liveFrom": {
+                    "type": "string"
+                },
+                "replacedAt": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "services.TimeSlot": {
+            "type": "object",
+            "properties": {
+                "averageEngagement": {
+                    "type": "number"
+                },
+                "hour": {
+                    "type": "integer"
+                },
+                "posts": {
+                    "type": "integer"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "weekday": {
                     "type": "string"
                 }
             }
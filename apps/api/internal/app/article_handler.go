@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -12,6 +13,7 @@ import (
 	"github.com/PuerkitoBio/goquery"
 	"github.com/gofiber/fiber/v2"
 	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
 	"github.com/postpilot/api/internal/services"
 	"go.uber.org/zap"
 )
@@ -29,15 +31,16 @@ func NewArticleHandler(articleService services.ArticleService, authService servi
 
 // GetSuggestions godoc
 // @Summary Get article suggestions from user sources
-// @Description Returns a list of technical articles from user-configured sources (RSS, dev.to, Hacker News)
+// @Description Returns a page of the articles ingested from the user's data sources (see GET /sources/types), newest first, along with the sources that could not be read. Sources are read in the background; a newly added source starts being read on the first request and is counted in pendingSources until its articles are in.
 // @Tags Articles
 // @Produce json
 // @Param q query string false "Search keyword" example(AI)
 // @Param from query string false "Published after (YYYY-MM-DD)" example(2024-05-01)
 // @Param to query string false "Published before (YYYY-MM-DD)" example(2024-06-01)
 // @Param tags query string false "Comma-separated tags" example("go,ai,architecture")
-// @Param limit query int false "Max articles (default 6, max 100)" example(10)
-// @Success 200 {array} services.Article "OK" x-example([{"title":"Go 1.22 Released","url":"https://dev.to/golang/go-1-22-released-1234","source":"DEV Community","publishedAt":"2024-05-01T12:00:00Z","summary":"Resumo do artigo em português...","tags":["go","release"]}])
+// @Param page query int false "Page, starting at 1" example(1)
+// @Param limit query int false "Max articles per page (default 6, max 100)" example(10)
+// @Success 200 {object} services.ArticleSuggestions "OK" x-example({"articles":[{"id":"665f1c2e8b3e4a0012345678","title":"Go 1.22 Released","url":"https://dev.to/golang/go-1-22-released-1234","source":"DEV Community","publishedAt":"2024-05-01T12:00:00Z","summary":"Resumo do artigo em português...","tags":["go","release"],"sourceType":"devto","canonicalUrl":"https://dev.to/golang/go-1-22-released-1234","firstSeenAt":"2024-05-01T12:10:00Z","fetchedAt":"2024-05-01T12:10:00Z"}],"sourceErrors":[{"type":"rss","url":"https://example.com/feed.xml","error":"https://example.com/feed.xml returned status 404"}],"page":1,"hasMore":true})
 // @Failure 401 {object} map[string]interface{} "{ \"error\": \"Invalid user claims\" }"
 // @Failure 500 {object} map[string]interface{} "{ \"error\": \"Internal server error\" }"
 // @Security BearerAuth
@@ -49,43 +52,149 @@ func (h *ArticleHandler) GetSuggestions(c *fiber.Ctx) error {
 	}
 	userId := user.ID.Hex()
 
-	q := c.Query("q")
 	fromStr := c.Query("from")
 	toStr := c.Query("to")
 	tagsStr := c.Query("tags")
 	limitStr := c.Query("limit")
 
-	var from, to *time.Time
+	query := services.SuggestionsQuery{Q: c.Query("q"), Page: 1, Limit: 6}
 	if fromStr != "" {
 		if t, err := time.Parse("2006-01-02", fromStr); err == nil {
-			from = &t
+			query.From = &t
 		}
 	}
 	if toStr != "" {
 		if t, err := time.Parse("2006-01-02", toStr); err == nil {
-			to = &t
+			query.To = &t
 		}
 	}
 
-	tags := []string{}
 	if tagsStr != "" {
-		tags = splitAndTrim(tagsStr, ",")
+		query.Tags = splitAndTrim(tagsStr, ",")
 	}
 
-	limit := 6
 	if limitStr != "" {
 		if l, err := strconv.Atoi(limitStr); err == nil && l > 0 && l <= 100 {
-			limit = l
+			query.Limit = l
+		}
+	}
+	if pageStr := c.Query("page"); pageStr != "" {
+		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
+			query.Page = p
 		}
 	}
 
-	articles, err := h.ArticleService.FetchSuggestions(c.Context(), user, q, from, to, tags, limit)
+	suggestions, err := h.ArticleService.FetchSuggestions(c.Context(), user, query)
 	if err != nil {
 		log.Logger.Error("Failed to fetch article suggestions", zap.Error(err), zap.String("userId", userId), zap.String("endpoint", endpointArticlesSuggestions))
 		return c.Status(http.StatusInternalServerError).JSON(map[string]interface{}{"error": err.Error()})
 	}
-	log.Logger.Info("Article suggestions fetched", zap.String("userId", userId), zap.String("endpoint", endpointArticlesSuggestions), zap.Int("count", len(articles)))
-	return c.Status(http.StatusOK).JSON(articles)
+	log.Logger.Info("Article suggestions fetched",
+		zap.String("userId", userId),
+		zap.String("endpoint", endpointArticlesSuggestions),
+		zap.Int("count", len(suggestions.Articles)),
+		zap.Int("failedSources", len(suggestions.SourceErrors)),
+	)
+	return c.Status(http.StatusOK).JSON(suggestions)
+}
+
+// SearchArticles godoc
+// @Summary Search articles of the user's data sources
+// @Description Full-text search over the articles ingested from the user's data sources, ranked by relevance, with words stemmed in Portuguese or English. Copies of a story read from several sources are merged. Pages are read with the nextCursor of the previous page.
+// @Tags Articles
+// @Produce json
+// @Param q query string false "Search text; supports \"exact phrases\" and -excluded words" example(arquitetura hexagonal)
+// @Param lang query string false "Stemming language of q: pt or en (detected when omitted)" Enums(pt, en)
+// @Param sort query string false "relevance (default with q), newest (default without q) or oldest" Enums(relevance, newest, oldest)
+// @Param sourceType query string false "Comma-separated data source types" example("rss,hackernews")
+// @Param source query string false "Source name, like the title of a feed" example(Hacker News)
+// @Param tags query string false "Comma-separated tags" example("go,ai")
+// @Param from query string false "Published after (YYYY-MM-DD)" example(2024-05-01)
+// @Param to query string false "Published before (YYYY-MM-DD)" example(2024-06-01)
+// @Param limit query int false "Max articles (default 20, max 100)" example(20)
+// @Param cursor query string false "nextCursor of the previous page"
+// @Success 200 {object} services.ArticleSearchResult
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /articles/search [get]
+func (h *ArticleHandler) SearchArticles(c *fiber.Ctx) error {
+	const endpoint = "/articles/search"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	query := services.ArticleSearchQuery{
+		Q:        c.Query("q"),
+		Language: c.Query("lang"),
+		Sort:     models.ArticleSort(c.Query("sort")),
+		Source:   strings.TrimSpace(c.Query("source")),
+		Cursor:   c.Query("cursor"),
+		Limit:    20,
+	}
+	switch query.Language {
+	case "", services.ArticleLanguagePortuguese, services.ArticleLanguageEnglish:
+	default:
+		return BadRequestError(c, "lang must be one of: pt, en")
+	}
+	switch query.Sort {
+	case "", models.ArticleSortRelevance, models.ArticleSortNewest, models.ArticleSortOldest:
+	default:
+		return BadRequestError(c, "sort must be one of: relevance, newest, oldest")
+	}
+	if v := c.Query("sourceType"); v != "" {
+		for _, t := range splitAndTrim(v, ",") {
+			query.SourceTypes = append(query.SourceTypes, models.DataSourceType(t))
+		}
+	}
+	if v := c.Query("tags"); v != "" {
+		query.Tags = splitAndTrim(v, ",")
+	}
+	if v := c.Query("from"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return BadRequestError(c, "Invalid from date, expected YYYY-MM-DD")
+		}
+		query.From = &t
+	}
+	if v := c.Query("to"); v != "" {
+		t, err := time.Parse("2006-01-02", v)
+		if err != nil {
+			return BadRequestError(c, "Invalid to date, expected YYYY-MM-DD")
+		}
+		query.To = &t
+	}
+	if v := c.Query("limit"); v != "" {
+		if l, err := strconv.Atoi(v); err == nil && l > 0 && l <= 100 {
+			query.Limit = l
+		}
+	}
+
+	result, err := h.ArticleService.Search(c.Context(), user, query)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidArticleCursor) {
+			return BadRequestError(c, err.Error())
+		}
+		log.Logger.Error("Failed to search articles", zap.Error(err), zap.String("userId", user.ID.Hex()), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(result)
+}
+
+// ListSourceTypes godoc
+// @Summary List data source types
+// @Description Returns the data source types that can be added to the user's profile and the configuration each one takes
+// @Tags Articles
+// @Produce json
+// @Success 200 {array} services.ArticleSourceType
+// @Failure 401 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /sources/types [get]
+func (h *ArticleHandler) ListSourceTypes(c *fiber.Ctx) error {
+	return c.JSON(services.ArticleSourceTypes())
 }
 
 func splitAndTrim(s, sep string) []string {
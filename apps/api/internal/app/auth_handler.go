@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/postpilot/api/internal/log"
@@ -41,7 +42,7 @@ func NewAuthHandler(authService services.AuthService) *AuthHandler {
 // @Tags User
 // @Accept json
 // @Produce json
-// @Param input body registerRequest true "User registration info"
+// @Param input body RegisterRequest true "User registration info"
 // @Success 201 {object} models.User
 // @Failure 400 {object} map[string]interface{}
 // @Router /auth/register [post]
@@ -78,7 +79,7 @@ type loginResponse struct {
 // @Tags User
 // @Accept json
 // @Produce json
-// @Param input body loginRequest true "Login info"
+// @Param input body LoginRequest true "Login info"
 // @Success 200 {object} loginResponse
 // @Failure 400 {object} map[string]interface{}
 // @Router /auth/login [post]
@@ -110,7 +111,7 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 // @Tags User
 // @Accept json
 // @Produce json
-// @Param input body socialLoginRequest true "Social login info"
+// @Param input body SocialLoginRequest true "Social login info"
 // @Success 200 {object} loginResponse
 // @Failure 400 {object} map[string]interface{}
 // @Router /auth/social [post]
@@ -143,7 +144,7 @@ type refreshResponse struct {
 // @Tags User
 // @Accept json
 // @Produce json
-// @Param input body refreshRequest true "Refresh token info"
+// @Param input body RefreshTokenRequest true "Refresh token info"
 // @Success 200 {object} refreshResponse
 // @Failure 400 {object} map[string]interface{}
 // @Router /auth/refresh [post]
@@ -167,11 +168,11 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 
 // UpdateProfile godoc
 // @Summary Update user profile/configuration
-// @Description Update OpenAI and data sources for the authenticated user
+// @Description Update OpenAI, the dev.to API key and data sources for the authenticated user. An empty devToApiKey keeps the saved key; DELETE /auth/devto/disconnect removes it.
 // @Tags User
 // @Accept json
 // @Produce json
-// @Param input body app.updateProfileRequest true "Profile update info" example({"openAiApiKey":"sk-...","openAiModel":"gpt-4","dataSources":[{"type":"rss","url":"https://martinfowler.com/feed.atom","tags":["architecture","ai"]},{"type":"devto","url":"https://dev.to","tags":["backend","cloud"]}]})
+// @Param input body UpdateProfileRequest true "Profile update info" example({"openAiApiKey":"sk-...","openAiModel":"gpt-4","dataSources":[{"type":"rss","url":"https://martinfowler.com/feed.atom","tags":["architecture","ai"]},{"type":"devto","url":"https://dev.to","tags":["backend","cloud"]}]})
 // @Success 200 {object} models.User "Exemplo de resposta: {\"id\":\"123\",\"name\":\"João Silva\",\"email\":\"joao@exemplo.com\",\"avatarUrl\":\"https://exemplo.com/avatar.jpg\",\"provider\":\"google\",\"providerId\":\"abc123\",\"openAiApiKey\":\"sk-...\",\"openAiModel\":\"gpt-4\",\"dataSources\":[{\"type\":\"rss\",\"url\":\"https://martinfowler.com/feed.atom\",\"tags\":[\"architecture\",\"ai\"]}],\"createdAt\":\"2024-01-01T00:00:00Z\",\"updatedAt\":\"2024-01-01T00:00:00Z\"}"
 // @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
@@ -204,9 +205,20 @@ func (h *AuthHandler) UpdateProfile(c *fiber.Ctx) error {
 		return ValidationError(c, err.Error())
 	}
 
+	dataSources := convertDataSources(req.DataSources)
+	for _, ds := range dataSources {
+		if err := services.ValidateDataSource(ds); err != nil {
+			log.Logger.Warn("Invalid data source", zap.Error(err), zap.String("userId", userId), zap.String("endpoint", "/me"))
+			return ValidationError(c, err.Error())
+		}
+	}
+
 	user.OpenAiApiKey = req.OpenAiApiKey
 	user.OpenAiModel = req.OpenAiModel
-	user.DataSources = convertDataSources(req.DataSources)
+	if req.DevToApiKey != "" {
+		user.DevToApiKey = req.DevToApiKey
+	}
+	user.DataSources = dataSources
 
 	err = h.AuthService.UpdateUser(c.Context(), user)
 	if err != nil {
@@ -253,8 +265,10 @@ func (h *AuthHandler) LinkedInAuthURL(c *fiber.Ctx) error {
 }
 
 type linkedinTokenResponse struct {
-	AccessToken string `json:"access_token"`
-	ExpiresIn   int    `json:"expires_in"`
+	AccessToken           string `json:"access_token"`
+	ExpiresIn             int    `json:"expires_in"`
+	RefreshToken          string `json:"refresh_token"`
+	RefreshTokenExpiresIn int    `json:"refresh_token_expires_in"`
 }
 
 type linkedinEmailResponse struct {
@@ -312,7 +326,7 @@ func fetchLinkedInUserInfo(token string) (*linkedinUserInfo, error) {
 	return &userInfo, nil
 }
 
-func exchangeLinkedInCodeForToken(code, clientID, clientSecret, redirectURI string) (string, error) {
+func exchangeLinkedInCodeForToken(code, clientID, clientSecret, redirectURI string) (*linkedinTokenResponse, error) {
 	data := url.Values{}
 	data.Set("grant_type", "authorization_code")
 	data.Set("code", code)
@@ -322,19 +336,19 @@ func exchangeLinkedInCodeForToken(code, clientID, clientSecret, redirectURI stri
 
 	resp, err := http.PostForm("https://www.linkedin.com/oauth/v2/accessToken", data)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf(errFailedToReadResponseBody, err)
+		return nil, fmt.Errorf(errFailedToReadResponseBody, err)
 	}
 
 	var tokenResp linkedinTokenResponse
 	if err := json.Unmarshal(body, &tokenResp); err != nil {
-		return "", err
+		return nil, err
 	}
-	return tokenResp.AccessToken, nil
+	return &tokenResp, nil
 }
 
 // GoogleAuthURL godoc
@@ -395,6 +409,31 @@ func (h *AuthHandler) DisconnectLinkedIn(c *fiber.Ctx) error {
 	return c.JSON(fiber.Map{"message": "LinkedIn disconnected successfully"})
 }
 
+// DisconnectDevTo godoc
+// @Summary Disconnect dev.to account
+// @Description Removes the dev.to API key from the user profile
+// @Tags DevTo
+// @Produce json
+// @Success 200 {object} map[string]string "Exemplo: {\"message\": \"dev.to disconnected successfully\" }"
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /auth/devto/disconnect [delete]
+func (h *AuthHandler) DisconnectDevTo(c *fiber.Ctx) error {
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, "/auth/devto/disconnect")
+	}
+
+	if err := h.AuthService.ClearDevToApiKey(c.Context(), user.ID); err != nil {
+		log.Logger.Error("Failed to disconnect dev.to", zap.Error(err), zap.String("userId", user.ID.Hex()))
+		return InternalError(c, "Failed to disconnect dev.to")
+	}
+
+	log.Logger.Info("dev.to disconnected successfully", zap.String("userId", user.ID.Hex()))
+	return c.JSON(fiber.Map{"message": "dev.to disconnected successfully"})
+}
+
 // Gera URL de consentimento para publicação no LinkedIn
 // @Summary Get LinkedIn publish consent URL
 // @Description Returns the LinkedIn OAuth URL for publishing posts (w_member_social)
@@ -472,11 +511,12 @@ func (h *AuthHandler) LinkedInPublishCallback(c *fiber.Ctx) error {
 	clientSecret := os.Getenv("LINKEDIN_CLIENT_SECRET")
 	redirectURI := os.Getenv("LINKEDIN_PUBLISH_REDIRECT_URI")
 
-	token, err := exchangeLinkedInCodeForToken(code, clientID, clientSecret, redirectURI)
+	tokenResp, err := exchangeLinkedInCodeForToken(code, clientID, clientSecret, redirectURI)
 	if err != nil {
 		log.Logger.Error("LinkedIn publish callback: token exchange failed", zap.Error(err))
 		return c.Redirect(frontendURL+"/app/profile?linkedin=error&reason=token_exchange_failed", fiber.StatusTemporaryRedirect)
 	}
+	token := tokenResp.AccessToken
 	if token == "" {
 		log.Logger.Error("LinkedIn publish callback: empty token received")
 		return c.Redirect(frontendURL+"/app/profile?linkedin=error&reason=empty_token", fiber.StatusTemporaryRedirect)
@@ -488,9 +528,19 @@ func (h *AuthHandler) LinkedInPublishCallback(c *fiber.Ctx) error {
 		return c.Redirect(frontendURL+"/app/profile?linkedin=error&reason=urn_fetch_failed", fiber.StatusTemporaryRedirect)
 	}
 
-	user.LinkedinAccessToken = token
-	user.LinkedinPersonUrn = personUrn
-	err = h.AuthService.UpdateUser(c.Context(), user)
+	// Expiries and the refresh token the response lacks are removed, not kept from a previous connection.
+	// Refresh tokens are only issued to apps enabled for programmatic refresh.
+	now := time.Now().UTC()
+	var expiresAt, refreshExpiresAt *time.Time
+	if tokenResp.ExpiresIn > 0 {
+		t := now.Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+		expiresAt = &t
+	}
+	if tokenResp.RefreshToken != "" && tokenResp.RefreshTokenExpiresIn > 0 {
+		t := now.Add(time.Duration(tokenResp.RefreshTokenExpiresIn) * time.Second)
+		refreshExpiresAt = &t
+	}
+	err = h.AuthService.ConnectLinkedIn(c.Context(), user.ID, personUrn, token, tokenResp.RefreshToken, expiresAt, refreshExpiresAt)
 	if err != nil {
 		log.Logger.Error("LinkedIn publish callback: failed to save token", zap.Error(err))
 		return c.Redirect(frontendURL+"/app/profile?linkedin=error&reason=save_failed", fiber.StatusTemporaryRedirect)
@@ -499,6 +549,8 @@ func (h *AuthHandler) LinkedInPublishCallback(c *fiber.Ctx) error {
 	log.Logger.Info("LinkedIn publish token saved successfully",
 		zap.String("userId", user.ID.Hex()),
 		zap.String("personUrn", personUrn),
+		zap.Timep("expiresAt", expiresAt),
+		zap.Bool("refreshable", tokenResp.RefreshToken != ""),
 	)
 
 	return c.Redirect(frontendURL+"/app/profile?linkedin=connected", fiber.StatusTemporaryRedirect)
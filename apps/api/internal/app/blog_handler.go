@@ -0,0 +1,262 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+type BlogHandler struct {
+	BlogService services.BlogService
+	AuthService services.AuthService
+}
+
+func NewBlogHandler(blogService services.BlogService, authService services.AuthService) *BlogHandler {
+	return &BlogHandler{BlogService: blogService, AuthService: authService}
+}
+
+// ConnectBlog godoc
+// @Summary Connect a self-hosted blog
+// @Description Saves a WordPress site (username and application password) or a Ghost site (Admin API key) after checking that the site accepts the credentials. The site must be a public https address. Credentials are never returned.
+// @Tags Blogs
+// @Accept json
+// @Produce json
+// @Param input body BlogConnectionRequest true "Blog site and credentials"
+// @Success 201 {object} models.BlogConnection
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /blogs [post]
+func (h *BlogHandler) ConnectBlog(c *fiber.Ctx) error {
+	const endpoint = "/blogs"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	var req BlogConnectionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	input := services.BlogConnectionInput{
+		Platform:            models.BlogPlatform(req.Platform),
+		SiteURL:             req.SiteURL,
+		Username:            req.Username,
+		ApplicationPassword: req.ApplicationPassword,
+		AdminAPIKey:         req.AdminAPIKey,
+	}
+	conn, err := h.BlogService.Connect(c.Context(), userObjID, input)
+	if err != nil {
+		return h.handleBlogError(c, err, userID, endpoint)
+	}
+	return c.Status(http.StatusCreated).JSON(conn)
+}
+
+// ListBlogs godoc
+// @Summary List connected blogs
+// @Description Returns the user's connected WordPress and Ghost sites, without their credentials
+// @Tags Blogs
+// @Produce json
+// @Success 200 {array} models.BlogConnection
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /blogs [get]
+func (h *BlogHandler) ListBlogs(c *fiber.Ctx) error {
+	const endpoint = "/blogs"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	connections, err := h.BlogService.ListConnections(c.Context(), userObjID)
+	if err != nil {
+		return h.handleBlogError(c, err, userID, endpoint)
+	}
+	return c.JSON(connections)
+}
+
+// UpdateBlog godoc
+// @Summary Update a connected blog
+// @Description Replaces the site URL and credentials of a connected blog, checking them against the site again
+// @Tags Blogs
+// @Accept json
+// @Produce json
+// @Param id path string true "Blog connection ID"
+// @Param input body UpdateBlogConnectionRequest true "Blog site and credentials"
+// @Success 200 {object} models.BlogConnection
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /blogs/{id} [put]
+func (h *BlogHandler) UpdateBlog(c *fiber.Ctx) error {
+	const endpoint = "/blogs/:id"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	connectionID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid blog connection ID format")
+	}
+
+	var req UpdateBlogConnectionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	input := services.BlogConnectionInput{
+		SiteURL:             req.SiteURL,
+		Username:            req.Username,
+		ApplicationPassword: req.ApplicationPassword,
+		AdminAPIKey:         req.AdminAPIKey,
+	}
+	conn, err := h.BlogService.UpdateConnection(c.Context(), userObjID, connectionID, input)
+	if err != nil {
+		return h.handleBlogError(c, err, userID, endpoint)
+	}
+	return c.JSON(conn)
+}
+
+// DeleteBlog godoc
+// @Summary Disconnect a blog
+// @Description Removes a connected blog and its credentials. Posts already published to it are kept.
+// @Tags Blogs
+// @Param id path string true "Blog connection ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /blogs/{id} [delete]
+func (h *BlogHandler) DeleteBlog(c *fiber.Ctx) error {
+	const endpoint = "/blogs/:id"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	connectionID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid blog connection ID format")
+	}
+
+	if err := h.BlogService.DeleteConnection(c.Context(), userObjID, connectionID); err != nil {
+		return h.handleBlogError(c, err, userID, endpoint)
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// PublishBlogPost godoc
+// @Summary Publish an article to a connected blog
+// @Description Creates a post on a WordPress or Ghost site, as a draft unless published is set, with tags and a feature image. The content is plain text split into paragraphs, or HTML with contentFormat=html; without content, the output of the generated post postLogId is used. The post is recorded as a story whose network is the blog platform. With the approval workflow enabled, postLogId must be an approved post and content, when given, its approved text.
+// @Tags Blogs
+// @Accept json
+// @Produce json
+// @Param id path string true "Blog connection ID"
+// @Param input body BlogPostRequest true "Article"
+// @Success 201 {object} models.SocialPostStories
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /blogs/{id}/posts [post]
+func (h *BlogHandler) PublishBlogPost(c *fiber.Ctx) error {
+	const endpoint = "/blogs/:id/posts"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	connectionID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid blog connection ID format")
+	}
+
+	var req BlogPostRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	input := services.BlogPostInput{
+		Title:           req.Title,
+		Content:         req.Content,
+		ContentIsHTML:   req.ContentFormat == "html",
+		Tags:            req.Tags,
+		FeatureImageURL: req.FeatureImageURL,
+		Published:       req.Published,
+	}
+	if req.PostLogID != "" {
+		postLogID, err := primitive.ObjectIDFromHex(req.PostLogID)
+		if err != nil {
+			return BadRequestError(c, "Invalid post log ID format")
+		}
+		input.PostLogID = postLogID
+	}
+
+	story, err := h.BlogService.Publish(c.Context(), user, connectionID, input)
+	if err != nil {
+		return h.handleBlogError(c, err, user.ID.Hex(), endpoint)
+	}
+	return c.Status(http.StatusCreated).JSON(story)
+}
+
+func (h *BlogHandler) handleBlogError(c *fiber.Ctx, err error, userID, endpoint string) error {
+	switch {
+	case errors.Is(err, services.ErrBlogConnectionNotFound), errors.Is(err, services.ErrPostNotFound):
+		return NotFoundError(c, err.Error())
+	case errors.Is(err, services.ErrPostNotApproved):
+		return ForbiddenError(c, err.Error())
+	case errors.Is(err, services.ErrInvalidBlogURL):
+		return ValidationError(c, err.Error())
+	case errors.Is(err, services.ErrBlogCredentialsRequired), errors.Is(err, services.ErrBlogVerificationFailed),
+		errors.Is(err, services.ErrBlogUnauthorized), errors.Is(err, services.ErrInvalidGhostAdminKey),
+		errors.Is(err, services.ErrEmptyPostText):
+		return BadRequestError(c, err.Error())
+	}
+	log.Logger.Error("Blog request failed", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+	return InternalError(c, err.Error())
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+// calendarFeedPath is the public path of the calendar feeds, followed by the token and .ics
+const calendarFeedPath = "/the-post-pilot/v1/calendar/"
+
+type CalendarHandler struct {
+	CalendarService services.CalendarService
+}
+
+func NewCalendarHandler(calendarService services.CalendarService) *CalendarHandler {
+	return &CalendarHandler{CalendarService: calendarService}
+}
+
+type calendarTokenResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+// CreateCalendarToken godoc
+// @Summary Create the calendar feed URL
+// @Description Creates a secret iCalendar feed URL with the user's scheduled and published posts, to subscribe to in Google Calendar or Outlook. Creating a new URL revokes the previous one. The token is only shown once.
+// @Tags Calendar
+// @Produce json
+// @Success 201 {object} calendarTokenResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /calendar/token [post]
+func (h *CalendarHandler) CreateCalendarToken(c *fiber.Ctx) error {
+	const endpoint = "/calendar/token"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	token, err := h.CalendarService.RotateToken(c.Context(), userObjID)
+	if err != nil {
+		log.Logger.Error("Failed to create calendar token", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	url := c.BaseURL() + calendarFeedPath + token + ".ics"
+	return c.Status(http.StatusCreated).JSON(calendarTokenResponse{Token: token, URL: url})
+}
+
+// RevokeCalendarToken godoc
+// @Summary Revoke the calendar feed URL
+// @Description Revokes the user's iCalendar feed URL; subscribed calendars stop updating
+// @Tags Calendar
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /calendar/token [delete]
+func (h *CalendarHandler) RevokeCalendarToken(c *fiber.Ctx) error {
+	const endpoint = "/calendar/token"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	if err := h.CalendarService.RevokeToken(c.Context(), userObjID); err != nil {
+		log.Logger.Error("Failed to revoke calendar token", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// GetCalendarFeed godoc
+// @Summary iCalendar feed of posts
+// @Description Public iCalendar feed of the user the token belongs to: scheduled posts as tentative events and published posts as confirmed events with a link to the post. Authenticated by the secret token in the URL instead of a JWT.
+// @Tags Calendar
+// @Produce text/calendar
+// @Param token path string true "Calendar feed token, optionally followed by .ics"
+// @Success 200 {string} string
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /calendar/{token}.ics [get]
+func (h *CalendarHandler) GetCalendarFeed(c *fiber.Ctx) error {
+	const endpoint = "/calendar/:token"
+	token := strings.TrimSuffix(c.Params("token"), ".ics")
+
+	feed, err := h.CalendarService.Feed(c.Context(), token)
+	if err != nil {
+		if errors.Is(err, services.ErrCalendarFeedNotFound) {
+			return NotFoundError(c, err.Error())
+		}
+		log.Logger.Error("Failed to render calendar feed", zap.Error(err), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	c.Set(fiber.HeaderContentType, "text/calendar; charset=utf-8")
+	c.Set(fiber.HeaderContentDisposition, `inline; filename="postpilot.ics"`)
+	c.Set(fiber.HeaderCacheControl, "private, max-age=300")
+	return c.Send(feed)
+}
@@ -0,0 +1,202 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+type CommentHandler struct {
+	CommentService services.CommentService
+	AuthService    services.AuthService
+}
+
+func NewCommentHandler(commentService services.CommentService, authService services.AuthService) *CommentHandler {
+	return &CommentHandler{CommentService: commentService, AuthService: authService}
+}
+
+// ListComments godoc
+// @Summary List the comment inbox
+// @Description Returns comments left on the user's published posts, newest first. Pages are read with the nextCursor of the previous page.
+// @Tags Comments
+// @Produce json
+// @Param status query string false "Filter by status (new, read, replying, replied)"
+// @Param limit query int false "Max comments (default 50, max 200)"
+// @Param cursor query string false "nextCursor of the previous page"
+// @Success 200 {object} services.CommentPage
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /comments [get]
+func (h *CommentHandler) ListComments(c *fiber.Ctx) error {
+	const endpoint = "/comments"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	status := models.CommentStatus(c.Query("status"))
+	switch status {
+	case "", models.CommentStatusNew, models.CommentStatusRead, models.CommentStatusReplying, models.CommentStatusReplied:
+	default:
+		return BadRequestError(c, "status must be one of: new, read, replying, replied")
+	}
+
+	page, err := h.CommentService.ListComments(c.Context(), userObjID, status, c.Query("cursor"), parseListLimit(c))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidCommentCursor) {
+			return BadRequestError(c, err.Error())
+		}
+		log.Logger.Error("Failed to list comments", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(page)
+}
+
+// UpdateComment godoc
+// @Summary Mark a comment as new or read
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Param input body UpdateCommentRequest true "New status"
+// @Success 200 {object} models.PostComment
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /comments/{id} [patch]
+func (h *CommentHandler) UpdateComment(c *fiber.Ctx) error {
+	const endpoint = "/comments/:id"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid comment ID format")
+	}
+
+	var req UpdateCommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	comment, err := h.CommentService.UpdateStatus(c.Context(), userObjID, commentID, models.CommentStatus(req.Status))
+	if err != nil {
+		return h.handleCommentError(c, err, userID, endpoint)
+	}
+	return c.JSON(comment)
+}
+
+// SuggestReplies godoc
+// @Summary Generate reply suggestions for a comment
+// @Description Uses the configured OpenAI model with the post and the comment as context
+// @Tags Comments
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Success 200 {object} models.PostComment
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /comments/{id}/suggestions [post]
+func (h *CommentHandler) SuggestReplies(c *fiber.Ctx) error {
+	const endpoint = "/comments/:id/suggestions"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid comment ID format")
+	}
+
+	comment, err := h.CommentService.SuggestReplies(c.Context(), user, commentID)
+	if err != nil {
+		return h.handleCommentError(c, err, user.ID.Hex(), endpoint)
+	}
+	return c.JSON(comment)
+}
+
+// ReplyComment godoc
+// @Summary Reply to a comment
+// @Description Posts the approved reply back to the comment through the network API
+// @Tags Comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Comment ID"
+// @Param input body ReplyCommentRequest true "Reply text"
+// @Success 200 {object} models.PostComment
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /comments/{id}/reply [post]
+func (h *CommentHandler) ReplyComment(c *fiber.Ctx) error {
+	const endpoint = "/comments/:id/reply"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	commentID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid comment ID format")
+	}
+
+	var req ReplyCommentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	comment, err := h.CommentService.Reply(c.Context(), user, commentID, req.Text)
+	if err != nil {
+		return h.handleCommentError(c, err, user.ID.Hex(), endpoint)
+	}
+	return c.JSON(comment)
+}
+
+func (h *CommentHandler) handleCommentError(c *fiber.Ctx, err error, userID, endpoint string) error {
+	switch {
+	case errors.Is(err, services.ErrCommentNotFound):
+		return NotFoundError(c, err.Error())
+	case errors.Is(err, services.ErrCommentReplyInProgress):
+		return ConflictError(c, err.Error())
+	case errors.Is(err, services.ErrCommentAlreadyReplied),
+		errors.Is(err, services.ErrLinkedInNotConnected),
+		errors.Is(err, services.ErrUnsupportedNetwork):
+		return BadRequestError(c, err.Error())
+	}
+	log.Logger.Error("Comment request failed", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+	return InternalError(c, err.Error())
+}
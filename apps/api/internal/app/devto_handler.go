@@ -0,0 +1,129 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+type DevToHandler struct {
+	DevToService services.DevToService
+	AuthService  services.AuthService
+}
+
+func NewDevToHandler(devToService services.DevToService, authService services.AuthService) *DevToHandler {
+	return &DevToHandler{DevToService: devToService, AuthService: authService}
+}
+
+// CreateArticle godoc
+// @Summary Cross-post an article to dev.to
+// @Description Creates an article on the user's dev.to account with the API key saved in the profile. Without published the article is saved as a dev.to draft. With the approval workflow enabled, postLogId must be an approved post and bodyMarkdown its approved text. The article is recorded as a story with network devto and the dev.to article ID as externalPostId.
+// @Tags DevTo
+// @Accept json
+// @Produce json
+// @Param input body DevToArticleRequest true "Article"
+// @Success 201 {object} models.SocialPostStories
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /devto/articles [post]
+func (h *DevToHandler) CreateArticle(c *fiber.Ctx) error {
+	const endpoint = "/devto/articles"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	var req DevToArticleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	story, err := h.DevToService.PublishArticle(c.Context(), user, devToArticleInput(req))
+	if err != nil {
+		return h.handleDevToError(c, err, user.ID.Hex(), endpoint)
+	}
+	return c.Status(http.StatusCreated).JSON(story)
+}
+
+// UpdateArticle godoc
+// @Summary Update a dev.to article
+// @Description Replaces the title, body, tags, canonical URL and published flag of an article created through POST /devto/articles. The previous body is kept as a revision of the story. With the approval workflow enabled, the new body must be the approved text of postLogId, or of the post the article came from.
+// @Tags DevTo
+// @Accept json
+// @Produce json
+// @Param id path string true "Social post story ID"
+// @Param input body DevToArticleRequest true "Article"
+// @Success 200 {object} models.SocialPostStories
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /devto/articles/{id} [put]
+func (h *DevToHandler) UpdateArticle(c *fiber.Ctx) error {
+	const endpoint = "/devto/articles/:id"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	storyID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid story ID format")
+	}
+
+	var req DevToArticleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	story, err := h.DevToService.UpdateArticle(c.Context(), user, storyID, devToArticleInput(req))
+	if err != nil {
+		return h.handleDevToError(c, err, user.ID.Hex(), endpoint)
+	}
+	return c.JSON(story)
+}
+
+func (h *DevToHandler) handleDevToError(c *fiber.Ctx, err error, userID, endpoint string) error {
+	switch {
+	case errors.Is(err, services.ErrStoryNotFound), errors.Is(err, services.ErrPostNotFound):
+		return NotFoundError(c, err.Error())
+	case errors.Is(err, services.ErrPostNotApproved):
+		return ForbiddenError(c, err.Error())
+	case errors.Is(err, services.ErrDevToNotConnected), errors.Is(err, services.ErrDevToUnauthorized),
+		errors.Is(err, services.ErrStoryNotDevTo):
+		return BadRequestError(c, err.Error())
+	}
+	log.Logger.Error("dev.to request failed", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+	return InternalError(c, err.Error())
+}
+
+func devToArticleInput(req DevToArticleRequest) services.DevToArticleInput {
+	postLogID, _ := primitive.ObjectIDFromHex(req.PostLogID)
+	return services.DevToArticleInput{
+		PostLogID:    postLogID,
+		Title:        req.Title,
+		BodyMarkdown: req.BodyMarkdown,
+		Tags:         req.Tags,
+		CanonicalURL: req.CanonicalURL,
+		Published:    req.Published,
+	}
+}
@@ -0,0 +1,473 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+type DraftHandler struct {
+	DraftService services.DraftService
+	AuthService  services.AuthService
+}
+
+func NewDraftHandler(draftService services.DraftService, authService services.AuthService) *DraftHandler {
+	return &DraftHandler{DraftService: draftService, AuthService: authService}
+}
+
+// CreateDraft godoc
+// @Summary Create a draft
+// @Description Creates an editable draft, optionally linked to a generated post and its source article. Without text, the generated output is used.
+// @Tags Drafts
+// @Accept json
+// @Produce json
+// @Param input body CreateDraftRequest true "Draft content"
+// @Success 201 {object} models.Draft
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts [post]
+func (h *DraftHandler) CreateDraft(c *fiber.Ctx) error {
+	const endpoint = "/drafts"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	var req CreateDraftRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	input := services.DraftInput{Title: req.Title, Text: req.Text, Parts: convertPostParts(req.Parts), Theme: req.Theme}
+	if req.PostLogID != "" {
+		postLogID, err := primitive.ObjectIDFromHex(req.PostLogID)
+		if err != nil {
+			return BadRequestError(c, "Invalid post log ID format")
+		}
+		input.PostLogID = postLogID
+	}
+	if req.Article != nil {
+		input.Article = &models.DraftArticle{URL: req.Article.URL, Title: req.Article.Title}
+	}
+
+	draft, err := h.DraftService.Create(c.Context(), user, input)
+	if err != nil {
+		return h.handleDraftError(c, err, user.ID.Hex(), endpoint)
+	}
+	return c.Status(http.StatusCreated).JSON(draft)
+}
+
+// ListDrafts godoc
+// @Summary List drafts
+// @Description Returns the user's drafts, most recently edited first
+// @Tags Drafts
+// @Produce json
+// @Param status query string false "Filter by status (draft, scheduled, publishing, published)"
+// @Param limit query int false "Max drafts (default 50, max 200)"
+// @Success 200 {array} models.Draft
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts [get]
+func (h *DraftHandler) ListDrafts(c *fiber.Ctx) error {
+	const endpoint = "/drafts"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	status := models.DraftStatus(c.Query("status"))
+	switch status {
+	case "", models.DraftStatusDraft, models.DraftStatusScheduled, models.DraftStatusPublishing, models.DraftStatusPublished:
+	default:
+		return BadRequestError(c, "status must be one of: draft, scheduled, publishing, published")
+	}
+
+	drafts, err := h.DraftService.List(c.Context(), userObjID, status, parseListLimit(c))
+	if err != nil {
+		log.Logger.Error("Failed to list drafts", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+	return c.JSON(drafts)
+}
+
+// GetDraft godoc
+// @Summary Get a draft
+// @Tags Drafts
+// @Produce json
+// @Param id path string true "Draft ID"
+// @Success 200 {object} models.Draft
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts/{id} [get]
+func (h *DraftHandler) GetDraft(c *fiber.Ctx) error {
+	const endpoint = "/drafts/:id"
+	userIDHex, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	draftID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid draft ID format")
+	}
+
+	draft, err := h.DraftService.Get(c.Context(), userID, draftID)
+	if err != nil {
+		return h.handleDraftError(c, err, userID.Hex(), endpoint)
+	}
+	return c.JSON(draft)
+}
+
+// UpdateDraft godoc
+// @Summary Update a draft
+// @Description Changes only the fields sent, which makes it suitable for autosave. The version must match the stored one, otherwise 409 is returned and the client should reload the draft.
+// @Tags Drafts
+// @Accept json
+// @Produce json
+// @Param id path string true "Draft ID"
+// @Param input body UpdateDraftRequest true "Fields to change and the version being edited"
+// @Success 200 {object} models.Draft
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts/{id} [patch]
+func (h *DraftHandler) UpdateDraft(c *fiber.Ctx) error {
+	const endpoint = "/drafts/:id"
+	userIDHex, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	draftID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid draft ID format")
+	}
+
+	var req UpdateDraftRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	patch := services.DraftPatch{Title: req.Title, Text: req.Text, Theme: req.Theme}
+	if req.Parts != nil {
+		parts := convertPostParts(*req.Parts)
+		patch.Parts = &parts
+	}
+	draft, err := h.DraftService.Update(c.Context(), userID, draftID, req.Version, patch)
+	if err != nil {
+		return h.handleDraftError(c, err, userID.Hex(), endpoint)
+	}
+	return c.JSON(draft)
+}
+
+// UpdateDraftPart godoc
+// @Summary Update one part of a multi-part draft
+// @Description Changes the title and/or text of a single part, identified by its zero-based position. An empty title removes it. The version must match the stored one, otherwise 409 is returned; text that does not fit on a carousel slide returns 422.
+// @Tags Drafts
+// @Accept json
+// @Produce json
+// @Param id path string true "Draft ID"
+// @Param index path int true "Part position, starting at 0"
+// @Param input body UpdateDraftPartRequest true "Fields to change and the version being edited"
+// @Success 200 {object} models.Draft
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts/{id}/parts/{index} [patch]
+func (h *DraftHandler) UpdateDraftPart(c *fiber.Ctx) error {
+	const endpoint = "/drafts/:id/parts/:index"
+	userIDHex, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	draftID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid draft ID format")
+	}
+	index, err := c.ParamsInt("index")
+	if err != nil {
+		return BadRequestError(c, "Invalid part index")
+	}
+
+	var req UpdateDraftPartRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	patch := services.DraftPartPatch{Title: req.Title, Text: req.Text}
+	draft, err := h.DraftService.UpdatePart(c.Context(), userID, draftID, req.Version, index, patch)
+	if err != nil {
+		return h.handleDraftError(c, err, userIDHex, endpoint)
+	}
+	return c.JSON(draft)
+}
+
+// DeleteDraft godoc
+// @Summary Delete a draft
+// @Tags Drafts
+// @Param id path string true "Draft ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts/{id} [delete]
+func (h *DraftHandler) DeleteDraft(c *fiber.Ctx) error {
+	const endpoint = "/drafts/:id"
+	userIDHex, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	draftID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid draft ID format")
+	}
+
+	if err := h.DraftService.Delete(c.Context(), userID, draftID); err != nil {
+		return h.handleDraftError(c, err, userID.Hex(), endpoint)
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// PublishDraft godoc
+// @Summary Publish a draft
+// @Description Publishes the current text of the draft to each target network, like /posts/{logId}/publish. The draft is marked as published when at least one target succeeds.
+// @Tags Drafts
+// @Accept json
+// @Produce json
+// @Param id path string true "Draft ID"
+// @Param input body PublishPostRequest true "Target networks"
+// @Success 200 {object} services.CrossPostResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts/{id}/publish [post]
+func (h *DraftHandler) PublishDraft(c *fiber.Ctx) error {
+	const endpoint = "/drafts/:id/publish"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	draftID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid draft ID format")
+	}
+
+	var req PublishPostRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	targets := make([]services.PublishTarget, len(req.Targets))
+	for i, t := range req.Targets {
+		targets[i] = services.PublishTarget{Network: t.Network, Text: t.Text, Campaign: t.Campaign}
+	}
+
+	resp, err := h.DraftService.Publish(c.Context(), user, draftID, targets)
+	if err != nil {
+		return h.handleDraftError(c, err, user.ID.Hex(), endpoint)
+	}
+
+	log.Logger.Info("Draft publish request completed",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("endpoint", endpoint),
+		zap.String("draftId", resp.DraftID),
+		zap.String("status", resp.Status),
+	)
+	return c.JSON(resp)
+}
+
+// ScheduleDraft godoc
+// @Summary Schedule a draft
+// @Description Schedules the draft to be published to the target networks at a given time, or at the next recommended slot (see /insights/best-times) with slot=next_recommended. The draft can still be edited until then. If publishing fails, the draft goes back to the draft status with the reason in scheduleError. With the approval workflow enabled, only drafts of an approved post with its approved text can be scheduled, and the post moves to the scheduled review status.
+// @Tags Drafts
+// @Accept json
+// @Produce json
+// @Param id path string true "Draft ID"
+// @Param input body ScheduleDraftRequest true "Time or slot, target networks and the version being edited"
+// @Success 200 {object} models.Draft
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts/{id}/schedule [post]
+func (h *DraftHandler) ScheduleDraft(c *fiber.Ctx) error {
+	const endpoint = "/drafts/:id/schedule"
+	userIDHex, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	draftID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid draft ID format")
+	}
+
+	var req ScheduleDraftRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	schedule := services.DraftSchedule{
+		NextRecommended: req.Slot == ScheduleSlotNextRecommended,
+		TimeZone:        req.TimeZone,
+		Targets:         make([]models.DraftTarget, len(req.Targets)),
+	}
+	if req.At != nil {
+		schedule.At = *req.At
+	}
+	for i, t := range req.Targets {
+		schedule.Targets[i] = models.DraftTarget{Network: t.Network, Text: t.Text, Campaign: t.Campaign}
+	}
+
+	draft, err := h.DraftService.Schedule(c.Context(), userID, draftID, req.Version, schedule)
+	if err != nil {
+		return h.handleDraftError(c, err, userID.Hex(), endpoint)
+	}
+	return c.JSON(draft)
+}
+
+// UnscheduleDraft godoc
+// @Summary Cancel the schedule of a draft
+// @Description Turns a scheduled draft back into a plain draft, and its post back to the approved review status. Drafts that are not scheduled are returned unchanged.
+// @Tags Drafts
+// @Accept json
+// @Produce json
+// @Param id path string true "Draft ID"
+// @Param input body DraftVersionRequest true "Version being edited"
+// @Success 200 {object} models.Draft
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /drafts/{id}/schedule [delete]
+func (h *DraftHandler) UnscheduleDraft(c *fiber.Ctx) error {
+	const endpoint = "/drafts/:id/schedule"
+	userIDHex, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userID, err := primitive.ObjectIDFromHex(userIDHex)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	draftID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid draft ID format")
+	}
+
+	var req DraftVersionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	draft, err := h.DraftService.Unschedule(c.Context(), userID, draftID, req.Version)
+	if err != nil {
+		return h.handleDraftError(c, err, userID.Hex(), endpoint)
+	}
+	return c.JSON(draft)
+}
+
+func (h *DraftHandler) handleDraftError(c *fiber.Ctx, err error, userID, endpoint string) error {
+	switch {
+	case errors.Is(err, services.ErrDraftNotFound), errors.Is(err, services.ErrPostNotFound), errors.Is(err, services.ErrDraftPartNotFound):
+		return NotFoundError(c, err.Error())
+	case errors.Is(err, services.ErrDraftVersionConflict), errors.Is(err, services.ErrDraftPublishing):
+		return ConflictError(c, err.Error())
+	case errors.Is(err, services.ErrPostNotApproved):
+		return ForbiddenError(c, err.Error())
+	case errors.Is(err, services.ErrSlideTextTooLong):
+		return ValidationError(c, err.Error())
+	case errors.Is(err, services.ErrDraftPublished), errors.Is(err, services.ErrInvalidStatusTransition),
+		errors.Is(err, services.ErrScheduleInPast), errors.Is(err, services.ErrInvalidTimeZone):
+		return BadRequestError(c, err.Error())
+	}
+	log.Logger.Error("Draft request failed", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+	return InternalError(c, err.Error())
+}
+
+func convertPostParts(parts []PostPartRequest) []models.PostPart {
+	if len(parts) == 0 {
+		return nil
+	}
+	result := make([]models.PostPart, len(parts))
+	for i, p := range parts {
+		result[i] = models.PostPart{Title: p.Title, Text: p.Text}
+	}
+	return result
+}
@@ -3,6 +3,7 @@ package app
 import (
 	"errors"
 	"net/http"
+	"strconv"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang-jwt/jwt/v4"
@@ -66,6 +67,7 @@ const (
 	ErrCodeInternalError    ErrorCode = "INTERNAL_ERROR"
 	ErrCodeValidationFailed ErrorCode = "VALIDATION_FAILED"
 	ErrCodeForbidden        ErrorCode = "FORBIDDEN"
+	ErrCodeConflict         ErrorCode = "CONFLICT"
 )
 
 // ErrorResponse sends a standardized error response
@@ -108,6 +110,19 @@ func ForbiddenError(c *fiber.Ctx, message string) error {
 	return ErrorResponse(c, http.StatusForbidden, ErrCodeForbidden, message)
 }
 
+// ConflictError returns a standardized 409 error
+func ConflictError(c *fiber.Ctx, message string) error {
+	return ErrorResponse(c, http.StatusConflict, ErrCodeConflict, message)
+}
+
+// parseListLimit reads the "limit" query parameter of list endpoints, defaulting to 50 and capped at 200
+func parseListLimit(c *fiber.Ctx) int {
+	if l, err := strconv.Atoi(c.Query("limit")); err == nil && l > 0 && l <= 200 {
+		return l
+	}
+	return 50
+}
+
 // HandleUserContextError handles errors from GetUserIDFromContext with proper logging
 func HandleUserContextError(c *fiber.Ctx, err error, endpoint string) error {
 	log.Logger.Warn("Failed to get user from context",
@@ -126,9 +141,13 @@ func convertDataSources(sources []DataSourceRequest) []models.DataSource {
 	result := make([]models.DataSource, len(sources))
 	for i, s := range sources {
 		result[i] = models.DataSource{
-			Type: models.DataSourceType(s.Type),
-			Url:  s.Url,
-			Tags: s.Tags,
+			Type:          models.DataSourceType(s.Type),
+			Url:           s.Url,
+			Tags:          s.Tags,
+			Listing:       s.Listing,
+			Window:        s.Window,
+			MinScore:      s.MinScore,
+			SkipSelfPosts: s.SkipSelfPosts,
 		}
 	}
 	return result
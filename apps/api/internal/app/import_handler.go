@@ -0,0 +1,193 @@
+package app
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var errUnsupportedImportFile = errors.New("send the posts as text/csv, application/json or a multipart .csv/.json file in the file field")
+
+type ImportHandler struct {
+	ImportService services.ImportService
+	AuthService   services.AuthService
+}
+
+func NewImportHandler(importService services.ImportService, authService services.AuthService) *ImportHandler {
+	return &ImportHandler{ImportService: importService, AuthService: authService}
+}
+
+// ImportPosts godoc
+// @Summary Bulk import posts
+// @Description Imports posts from a CSV file with a header row or a JSON array, with the columns text, network, scheduledAt (RFC 3339, or YYYY-MM-DD HH:MM in timeZone), link and imageUrl. The link is appended to the text when it is not in it yet. Images are not published yet, so the imageUrl of a row is ignored with a warning in the row results. With dryRun=true the rows are only validated. Otherwise every row becomes a draft, scheduled when it has a time, in a single batch; if any row is invalid nothing is imported and 422 is returned with the per-row errors.
+// @Tags Posts
+// @Accept text/csv
+// @Accept json
+// @Accept mpfd
+// @Produce json
+// @Param dryRun query bool false "Only validate the rows"
+// @Param timeZone query string false "IANA time zone of scheduled times without an offset (default UTC)"
+// @Param file formData file false "CSV or JSON file, when sent as multipart"
+// @Success 200 {object} models.PostImport "Dry run results"
+// @Success 201 {object} models.PostImport
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 422 {object} models.PostImport
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /posts/import [post]
+func (h *ImportHandler) ImportPosts(c *fiber.Ctx) error {
+	const endpoint = "/posts/import"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	dryRun := false
+	if v := c.Query("dryRun"); v != "" {
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			return BadRequestError(c, "Invalid dryRun value")
+		}
+	}
+
+	format, data, err := readImportFile(c)
+	if err != nil {
+		return BadRequestError(c, err.Error())
+	}
+
+	options := services.ImportOptions{DryRun: dryRun, TimeZone: c.Query("timeZone")}
+	result, err := h.ImportService.Import(c.Context(), user, format, data, options)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrImportHasInvalidRows):
+			return c.Status(http.StatusUnprocessableEntity).JSON(result)
+		case errors.Is(err, services.ErrInvalidImportFile), errors.Is(err, services.ErrImportEmpty),
+			errors.Is(err, services.ErrImportTooManyRows), errors.Is(err, services.ErrInvalidTimeZone):
+			return BadRequestError(c, err.Error())
+		}
+		log.Logger.Error("Failed to import posts", zap.Error(err), zap.String("userId", user.ID.Hex()), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	if dryRun {
+		return c.JSON(result)
+	}
+	return c.Status(http.StatusCreated).JSON(result)
+}
+
+// ListImports godoc
+// @Summary List post imports
+// @Description Returns the user's committed imports, newest first, without their per-row results
+// @Tags Posts
+// @Produce json
+// @Param limit query int false "Max imports (default 50, max 200)"
+// @Success 200 {array} models.PostImport
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /posts/imports [get]
+func (h *ImportHandler) ListImports(c *fiber.Ctx) error {
+	const endpoint = "/posts/imports"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	imports, err := h.ImportService.List(c.Context(), userObjID, parseListLimit(c))
+	if err != nil {
+		log.Logger.Error("Failed to list imports", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+	return c.JSON(imports)
+}
+
+// GetImport godoc
+// @Summary Get a post import
+// @Description Returns a committed import with the result of each row and the draft created for it
+// @Tags Posts
+// @Produce json
+// @Param id path string true "Import ID"
+// @Success 200 {object} models.PostImport
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /posts/imports/{id} [get]
+func (h *ImportHandler) GetImport(c *fiber.Ctx) error {
+	const endpoint = "/posts/imports/:id"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	importID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid import ID format")
+	}
+
+	postImport, err := h.ImportService.Get(c.Context(), userObjID, importID)
+	if err != nil {
+		if errors.Is(err, services.ErrImportNotFound) {
+			return NotFoundError(c, err.Error())
+		}
+		log.Logger.Error("Failed to get import", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+	return c.JSON(postImport)
+}
+
+// readImportFile returns the import file and its format, from the request body or from the multipart file field
+func readImportFile(c *fiber.Ctx) (models.ImportFormat, []byte, error) {
+	contentType := strings.ToLower(c.Get(fiber.HeaderContentType))
+	switch {
+	case strings.HasPrefix(contentType, "text/csv"):
+		return models.ImportFormatCSV, c.Body(), nil
+	case strings.HasPrefix(contentType, fiber.MIMEApplicationJSON):
+		return models.ImportFormatJSON, c.Body(), nil
+	case strings.HasPrefix(contentType, fiber.MIMEMultipartForm):
+		fileHeader, err := c.FormFile("file")
+		if err != nil {
+			return "", nil, errUnsupportedImportFile
+		}
+		var format models.ImportFormat
+		switch strings.ToLower(filepath.Ext(fileHeader.Filename)) {
+		case ".csv":
+			format = models.ImportFormatCSV
+		case ".json":
+			format = models.ImportFormatJSON
+		default:
+			return "", nil, errUnsupportedImportFile
+		}
+		file, err := fileHeader.Open()
+		if err != nil {
+			return "", nil, err
+		}
+		defer file.Close()
+		data, err := io.ReadAll(file)
+		if err != nil {
+			return "", nil, err
+		}
+		return format, data, nil
+	}
+	return "", nil, errUnsupportedImportFile
+}
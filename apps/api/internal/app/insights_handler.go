@@ -0,0 +1,53 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+type InsightsHandler struct {
+	InsightsService services.InsightsService
+}
+
+func NewInsightsHandler(insightsService services.InsightsService) *InsightsHandler {
+	return &InsightsHandler{InsightsService: insightsService}
+}
+
+// GetBestTimes godoc
+// @Summary Recommended times to post
+// @Description Ranks the weekday/hour slots of the user's published posts by engagement, in the given time zone. Accounts with fewer than 5 posts with metrics get default slots (source=default). nextSlot is the next upcoming recommended slot, which drafts can be scheduled to with slot=next_recommended.
+// @Tags Insights
+// @Produce json
+// @Param timeZone query string false "IANA time zone, e.g. America/Sao_Paulo (default UTC)"
+// @Success 200 {object} services.BestTimesResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /insights/best-times [get]
+func (h *InsightsHandler) GetBestTimes(c *fiber.Ctx) error {
+	const endpoint = "/insights/best-times"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	resp, err := h.InsightsService.BestTimes(c.Context(), userObjID, c.Query("timeZone"))
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidTimeZone) {
+			return BadRequestError(c, err.Error())
+		}
+		log.Logger.Error("Failed to get best times", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+	return c.JSON(resp)
+}
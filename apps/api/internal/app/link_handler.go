@@ -0,0 +1,44 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/services"
+	"go.uber.org/zap"
+)
+
+type LinkHandler struct {
+	LinkService services.LinkService
+}
+
+func NewLinkHandler(linkService services.LinkService) *LinkHandler {
+	return &LinkHandler{LinkService: linkService}
+}
+
+// RedirectShortLink godoc
+// @Summary Follow a short link
+// @Description Redirects to the target of a short link created when a post was published, recording the click with its referrer. Clicks are counted in the metrics of the post.
+// @Tags Links
+// @Param code path string true "Short link code"
+// @Success 302
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Router /l/{code} [get]
+func (h *LinkHandler) RedirectShortLink(c *fiber.Ctx) error {
+	const endpoint = "/l/:code"
+	code := c.Params("code")
+
+	target, err := h.LinkService.Resolve(c.Context(), code, c.Get(fiber.HeaderReferer), c.Get(fiber.HeaderUserAgent))
+	if err != nil {
+		if errors.Is(err, services.ErrShortLinkNotFound) {
+			return NotFoundError(c, err.Error())
+		}
+		log.Logger.Error("Failed to resolve short link", zap.Error(err), zap.String("code", code), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	// 302 rather than 301, so browsers do not cache the redirect and every click is counted
+	return c.Redirect(target, fiber.StatusFound)
+}
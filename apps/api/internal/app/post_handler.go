@@ -1,10 +1,12 @@
 package app
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
 	"github.com/postpilot/api/internal/services"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.uber.org/zap"
@@ -13,21 +15,22 @@ import (
 const endpointPostsGenerate = "/posts/generate"
 
 type PostHandler struct {
-	PostService services.PostService
-	AuthService services.AuthService
+	PostService    services.PostService
+	PreviewService services.PreviewService
+	AuthService    services.AuthService
 }
 
-func NewPostHandler(postService services.PostService, authService services.AuthService) *PostHandler {
-	return &PostHandler{PostService: postService, AuthService: authService}
+func NewPostHandler(postService services.PostService, previewService services.PreviewService, authService services.AuthService) *PostHandler {
+	return &PostHandler{PostService: postService, PreviewService: previewService, AuthService: authService}
 }
 
 // Generate godoc
 // @Summary Generate post suggestion using OpenAI
-// @Description Gera sugestão de post a partir de um tema/artigo usando OpenAI
+// @Description Gera sugestão de post a partir de um tema/artigo usando OpenAI. Com "parts", gera um post em partes (thread ou carrossel).
 // @Tags Posts
 // @Accept json
 // @Produce json
-// @Param input body generatePostRequest true "Dados para geração do post"
+// @Param input body GeneratePostRequest true "Dados para geração do post"
 // @Success 200 {object} generatePostResponse
 // @Failure 400 {object} map[string]interface{}
 // @Failure 401 {object} map[string]interface{}
@@ -52,7 +55,7 @@ func (h *PostHandler) Generate(c *fiber.Ctx) error {
 		return ValidationError(c, err.Error())
 	}
 
-	resp, err := h.PostService.GeneratePost(c.Context(), user, req.Topic)
+	resp, err := h.PostService.GeneratePost(c.Context(), user, req.Topic, req.Parts)
 	if err != nil {
 		log.Logger.Error("Failed to generate post", zap.Error(err), zap.String("userId", userId), zap.String("endpoint", endpointPostsGenerate))
 		return InternalError(c, err.Error())
@@ -73,6 +76,116 @@ type generatePostResponse struct {
 	Usage         map[string]interface{} `json:"usage,omitempty"`
 	CreatedAt     string                 `json:"createdAt"`
 	LogId         string                 `json:"logId"`
+	Parts         []models.PostPart      `json:"parts,omitempty"`
+}
+
+// PreviewPost godoc
+// @Summary Preview a post on each network
+// @Description Renders the text as each network shows it: where LinkedIn folds it behind "ver mais", how hashtags and mentions render, where the link card goes and the character count under the network's rules (X counts links as 23 and CJK/emoji as 2). Each preview includes an HTML snippet that can be embedded as is.
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param input body PreviewPostRequest true "Text and networks (default: all)"
+// @Success 200 {object} previewPostResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /posts/preview [post]
+func (h *PostHandler) PreviewPost(c *fiber.Ctx) error {
+	const endpoint = "/posts/preview"
+	if _, err := GetUserIDFromContext(c); err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	var req PreviewPostRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	return c.JSON(previewPostResponse{Previews: h.PreviewService.Preview(req.Text, req.Networks)})
+}
+
+type previewPostResponse struct {
+	Previews []services.NetworkPreview `json:"previews"`
+}
+
+// PublishPost godoc
+// @Summary Cross-post a generated post to several networks
+// @Description Publishes a generated post to each target network, with optional per-target text. A failure on one target does not abort the others.
+// @Tags Posts
+// @Accept json
+// @Produce json
+// @Param logId path string true "Post generation log ID"
+// @Param input body PublishPostRequest true "Target networks"
+// @Success 200 {object} services.CrossPostResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /posts/{logId}/publish [post]
+func (h *PostHandler) PublishPost(c *fiber.Ctx) error {
+	const endpoint = "/posts/:logId/publish"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userId := user.ID.Hex()
+
+	postLogID, err := primitive.ObjectIDFromHex(c.Params("logId"))
+	if err != nil {
+		log.Logger.Warn("Invalid post log ID format",
+			zap.String("userId", userId),
+			zap.String("postLogId", c.Params("logId")),
+			zap.String("endpoint", endpoint),
+		)
+		return BadRequestError(c, "Invalid post log ID format")
+	}
+
+	var req PublishPostRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Logger.Warn("Invalid publish payload", zap.Error(err), zap.String("userId", userId), zap.String("endpoint", endpoint))
+		return BadRequestError(c, err.Error())
+	}
+
+	if err := ValidateStruct(&req); err != nil {
+		log.Logger.Warn("Publish validation failed", zap.Error(err), zap.String("userId", userId), zap.String("endpoint", endpoint))
+		return ValidationError(c, err.Error())
+	}
+
+	targets := make([]services.PublishTarget, len(req.Targets))
+	for i, t := range req.Targets {
+		targets[i] = services.PublishTarget{Network: t.Network, Text: t.Text, Campaign: t.Campaign}
+	}
+
+	resp, err := h.PostService.CrossPost(c.Context(), user, postLogID, targets)
+	if err != nil {
+		if errors.Is(err, services.ErrPostNotFound) {
+			return NotFoundError(c, "Post not found")
+		}
+		if errors.Is(err, services.ErrPostNotApproved) {
+			return ForbiddenError(c, err.Error())
+		}
+		if errors.Is(err, services.ErrInvalidStatusTransition) {
+			return BadRequestError(c, err.Error())
+		}
+		log.Logger.Error("Failed to cross-post", zap.Error(err), zap.String("userId", userId), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	log.Logger.Info("Cross-post request completed",
+		zap.String("userId", userId),
+		zap.String("endpoint", endpoint),
+		zap.String("postLogId", resp.PostLogID),
+		zap.String("status", resp.Status),
+	)
+	return c.JSON(resp)
 }
 
 // PublishLinkedInPost godoc
@@ -81,10 +194,11 @@ type generatePostResponse struct {
 // @Tags LinkedIn
 // @Accept json
 // @Produce json
-// @Param input body publishLinkedInPostRequest true "Post content"
+// @Param input body PublishLinkedInPostRequest true "Post content"
 // @Success 200 {object} map[string]interface{} "Exemplo: {\"status\": \"published\", \"linkedinPostId\": \"urn:li:share:...\" }"
 // @Failure 400 {object} map[string]interface{} "Exemplo: {\"error\": \"Missing text\" }"
 // @Failure 401 {object} map[string]interface{} "Exemplo: {\"error\": \"Unauthorized\" }"
+// @Failure 403 {object} map[string]interface{} "Exemplo: {\"error\": \"post must be approved before it can be published\" }"
 // @Failure 500 {object} map[string]interface{} "Exemplo: {\"error\": \"Failed to publish on LinkedIn\" }"
 // @Security BearerAuth
 // @Router /linkedin/publish [post]
@@ -128,6 +242,16 @@ func (h *PostHandler) PublishLinkedInPost(c *fiber.Ctx) error {
 		postLogID, _ = primitive.ObjectIDFromHex(req.PostLogID)
 	}
 
+	if err := h.PostService.EnsureApproved(c.Context(), user, postLogID, req.Text); err != nil {
+		switch {
+		case errors.Is(err, services.ErrPostNotApproved):
+			return ForbiddenError(c, err.Error())
+		case errors.Is(err, services.ErrPostNotFound):
+			return NotFoundError(c, "Post not found")
+		}
+		return InternalError(c, err.Error())
+	}
+
 	log.Logger.Info("Starting LinkedIn publish request",
 		zap.String("userId", userId),
 		zap.String("endpoint", endpoint),
@@ -135,7 +259,7 @@ func (h *PostHandler) PublishLinkedInPost(c *fiber.Ctx) error {
 		zap.String("postLogId", req.PostLogID),
 	)
 
-	linkedinPostId, err := h.PostService.PublishOnLinkedIn(c.Context(), user.ID, postLogID, user.LinkedinAccessToken, user.LinkedinPersonUrn, req.Text)
+	linkedinPostId, err := h.PostService.PublishOnLinkedIn(c.Context(), user, postLogID, req.Text)
 	if err != nil {
 		log.Logger.Error("Failed to publish on LinkedIn",
 			zap.Error(err),
@@ -215,7 +339,7 @@ func (h *PostHandler) DeleteLinkedInPost(c *fiber.Ctx) error {
 
 	var externalPostID string
 	for _, post := range posts {
-		if post.ID == postLogID && post.Status == "published" {
+		if post.ID == postLogID && post.Status == models.PostStatusPublished {
 			// We need to get the external ID from SocialPostStories
 			// For now, we'll handle this in the service
 			break
@@ -230,6 +354,12 @@ func (h *PostHandler) DeleteLinkedInPost(c *fiber.Ctx) error {
 
 	err = h.PostService.DeleteLinkedInPost(c.Context(), user.ID, postLogID, user.LinkedinAccessToken, externalPostID)
 	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPostNotFound):
+			return NotFoundError(c, "Post not found")
+		case errors.Is(err, services.ErrInvalidStatusTransition):
+			return BadRequestError(c, err.Error())
+		}
 		log.Logger.Error("Failed to delete LinkedIn post",
 			zap.Error(err),
 			zap.String("userId", userId),
@@ -0,0 +1,257 @@
+package app
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+type ReviewHandler struct {
+	ReviewService       services.ReviewService
+	NotificationService services.NotificationService
+	AuthService         services.AuthService
+}
+
+func NewReviewHandler(reviewService services.ReviewService, notificationService services.NotificationService, authService services.AuthService) *ReviewHandler {
+	return &ReviewHandler{ReviewService: reviewService, NotificationService: notificationService, AuthService: authService}
+}
+
+// UpdateSettings godoc
+// @Summary Configure the approval workflow
+// @Description Enables the approval workflow and assigns the reviewers of the user's posts. Once enabled, only a reviewer can disable it, through POST /review/authors/{id}/disable.
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Param input body UpdateReviewSettingsRequest true "Workflow settings"
+// @Success 200 {object} models.ReviewSettings
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /review/settings [put]
+func (h *ReviewHandler) UpdateSettings(c *fiber.Ctx) error {
+	const endpoint = "/review/settings"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	var req UpdateReviewSettingsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	settings, err := h.ReviewService.UpdateSettings(c.Context(), user, req.Enabled, req.ReviewerEmails)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrReviewerNotFound), errors.Is(err, services.ErrSelfReview), errors.Is(err, services.ErrReviewersRequired):
+			return BadRequestError(c, err.Error())
+		case errors.Is(err, services.ErrReviewDisableNotAllowed):
+			return ForbiddenError(c, err.Error())
+		}
+		log.Logger.Error("Failed to update review settings", zap.Error(err), zap.String("userId", user.ID.Hex()), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(settings)
+}
+
+// DisableForAuthor godoc
+// @Summary Disable the approval workflow of an author
+// @Description Lets one of the author's reviewers turn off their approval workflow. The reviewers stay assigned and the author is notified.
+// @Tags Review
+// @Produce json
+// @Param id path string true "Author user ID"
+// @Success 200 {object} models.ReviewSettings
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /review/authors/{id}/disable [post]
+func (h *ReviewHandler) DisableForAuthor(c *fiber.Ctx) error {
+	const endpoint = "/review/authors/:id/disable"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	authorID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID format")
+	}
+
+	settings, err := h.ReviewService.DisableForAuthor(c.Context(), user, authorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrReviewNotAllowed):
+			return ForbiddenError(c, err.Error())
+		case errors.Is(err, services.ErrReviewWorkflowDisabled):
+			return BadRequestError(c, err.Error())
+		}
+		log.Logger.Error("Failed to disable review workflow", zap.Error(err), zap.String("userId", user.ID.Hex()), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(settings)
+}
+
+// ReviewPost godoc
+// @Summary Move a post through the approval workflow
+// @Description Authors submit posts for review; reviewers approve them or request changes with a comment
+// @Tags Review
+// @Accept json
+// @Produce json
+// @Param logId path string true "Post generation log ID"
+// @Param input body ReviewActionRequest true "Review action"
+// @Success 200 {object} models.PostGenerationLog
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 403 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /posts/{logId}/review [post]
+func (h *ReviewHandler) ReviewPost(c *fiber.Ctx) error {
+	const endpoint = "/posts/:logId/review"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	postLogID, err := primitive.ObjectIDFromHex(c.Params("logId"))
+	if err != nil {
+		return BadRequestError(c, "Invalid post log ID format")
+	}
+
+	var req ReviewActionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	postLog, err := h.ReviewService.Transition(c.Context(), user, postLogID, services.ReviewAction(req.Action), req.Comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrPostNotFound):
+			return NotFoundError(c, "Post not found")
+		case errors.Is(err, services.ErrReviewNotAllowed):
+			return ForbiddenError(c, err.Error())
+		case errors.Is(err, services.ErrInvalidReviewTransition),
+			errors.Is(err, services.ErrReviewCommentRequired),
+			errors.Is(err, services.ErrReviewWorkflowDisabled):
+			return BadRequestError(c, err.Error())
+		}
+		log.Logger.Error("Failed to review post", zap.Error(err), zap.String("userId", user.ID.Hex()), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(postLog)
+}
+
+// ListQueue godoc
+// @Summary List posts waiting for my review
+// @Tags Review
+// @Produce json
+// @Param limit query int false "Max posts (default 50, max 200)"
+// @Success 200 {array} models.PostGenerationLog
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /review/queue [get]
+func (h *ReviewHandler) ListQueue(c *fiber.Ctx) error {
+	const endpoint = "/review/queue"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	posts, err := h.ReviewService.ListQueue(c.Context(), userObjID, parseListLimit(c))
+	if err != nil {
+		log.Logger.Error("Failed to list review queue", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+	return c.JSON(posts)
+}
+
+// ListNotifications godoc
+// @Summary List notifications
+// @Tags Notifications
+// @Produce json
+// @Param unread query bool false "Only unread notifications"
+// @Param limit query int false "Max notifications (default 50, max 200)"
+// @Success 200 {array} models.Notification
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /notifications [get]
+func (h *ReviewHandler) ListNotifications(c *fiber.Ctx) error {
+	const endpoint = "/notifications"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	unreadOnly, _ := strconv.ParseBool(c.Query("unread"))
+	notifications, err := h.NotificationService.List(c.Context(), userObjID, unreadOnly, parseListLimit(c))
+	if err != nil {
+		log.Logger.Error("Failed to list notifications", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+	return c.JSON(notifications)
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Tags Notifications
+// @Param id path string true "Notification ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /notifications/{id}/read [post]
+func (h *ReviewHandler) MarkNotificationRead(c *fiber.Ctx) error {
+	const endpoint = "/notifications/:id/read"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	notificationID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid notification ID format")
+	}
+
+	if err := h.NotificationService.MarkRead(c.Context(), userObjID, notificationID); err != nil {
+		if errors.Is(err, services.ErrNotificationNotFound) {
+			return NotFoundError(c, err.Error())
+		}
+		log.Logger.Error("Failed to mark notification as read", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -10,12 +10,15 @@ import (
 	"github.com/postpilot/api/internal/middleware"
 )
 
-func RegisterRoutes(app *fiber.App, authHandler *AuthHandler, articleHandler *ArticleHandler, postHandler *PostHandler) {
+func RegisterRoutes(app *fiber.App, authHandler *AuthHandler, articleHandler *ArticleHandler, postHandler *PostHandler, storyHandler *StoryHandler, commentHandler *CommentHandler, reviewHandler *ReviewHandler, draftHandler *DraftHandler, webhookHandler *WebhookHandler, insightsHandler *InsightsHandler, linkHandler *LinkHandler, importHandler *ImportHandler, calendarHandler *CalendarHandler, devToHandler *DevToHandler, blogHandler *BlogHandler) {
 	// Root health check (for load balancers, k8s probes, etc.)
 	app.Get("/", func(c *fiber.Ctx) error {
 		return c.JSON(fiber.Map{"status": "ok", "service": "post-pilot-api"})
 	})
 
+	// Short links of published posts (públicas)
+	app.Get("/l/:code", linkHandler.RedirectShortLink)
+
 	// Swagger docs
 	app.Get("/the-post-pilot/swagger/*", swagger.New())
 
@@ -43,16 +46,68 @@ func RegisterRoutes(app *fiber.App, authHandler *AuthHandler, articleHandler *Ar
 	auth.Get("/google/url", authHandler.GoogleAuthURL)
 	auth.Get("/google/callback", authHandler.GoogleCallback)
 
+	// Calendar feeds (públicos, autenticados pelo token secreto da URL)
+	app.Get(calendarFeedPath+":token", calendarHandler.GetCalendarFeed)
+
 	// Rotas protegidas
 	protected := app.Group("/the-post-pilot/v1", middleware.JWTAuth(os.Getenv("JWT_SECRET")))
 	protected.Get("/me", authHandler.GetProfile)
 	protected.Put("/me", authHandler.UpdateProfile)
 	protected.Get("/articles/suggestions", articleHandler.GetSuggestions)
 	protected.Get("/articles/suggestions/by/duckduckgo", articleHandler.DuckDuckGoSuggestionsHandler)
+	protected.Get("/articles/search", articleHandler.SearchArticles)
+	protected.Get("/sources/types", articleHandler.ListSourceTypes)
 	protected.Post("/posts/generate", postHandler.Generate)
+	protected.Post("/posts/preview", postHandler.PreviewPost)
+	protected.Post("/posts/import", importHandler.ImportPosts)
+	protected.Get("/posts/imports", importHandler.ListImports)
+	protected.Get("/posts/imports/:id", importHandler.GetImport)
 	protected.Get("/posts", postHandler.ListPosts)
+	protected.Post("/posts/:logId/publish", postHandler.PublishPost)
 	protected.Get("/auth/linkedin/publish-url", authHandler.LinkedInPublishURL)
 	protected.Delete("/auth/linkedin/disconnect", authHandler.DisconnectLinkedIn)
+	protected.Delete("/auth/devto/disconnect", authHandler.DisconnectDevTo)
 	protected.Post("/linkedin/publish", postHandler.PublishLinkedInPost)
 	protected.Delete("/linkedin/post/:postLogId", postHandler.DeleteLinkedInPost)
+	protected.Get("/stories/metrics/summary", storyHandler.GetMetricsSummary)
+	protected.Patch("/stories/:id", storyHandler.EditStory)
+	protected.Get("/stories/:id/revisions", storyHandler.ListRevisions)
+	protected.Get("/stories/:id/metrics", storyHandler.GetMetrics)
+	protected.Put("/stories/:id/evergreen", storyHandler.SetEvergreen)
+	protected.Get("/stories/:id/reshares", storyHandler.ListReshares)
+	protected.Post("/devto/articles", devToHandler.CreateArticle)
+	protected.Put("/devto/articles/:id", devToHandler.UpdateArticle)
+	protected.Post("/blogs", blogHandler.ConnectBlog)
+	protected.Get("/blogs", blogHandler.ListBlogs)
+	protected.Put("/blogs/:id", blogHandler.UpdateBlog)
+	protected.Delete("/blogs/:id", blogHandler.DeleteBlog)
+	protected.Post("/blogs/:id/posts", blogHandler.PublishBlogPost)
+	protected.Get("/comments", commentHandler.ListComments)
+	protected.Patch("/comments/:id", commentHandler.UpdateComment)
+	protected.Post("/comments/:id/suggestions", commentHandler.SuggestReplies)
+	protected.Post("/comments/:id/reply", commentHandler.ReplyComment)
+	protected.Put("/review/settings", reviewHandler.UpdateSettings)
+	protected.Get("/review/queue", reviewHandler.ListQueue)
+	protected.Post("/review/authors/:id/disable", reviewHandler.DisableForAuthor)
+	protected.Post("/posts/:logId/review", reviewHandler.ReviewPost)
+	protected.Get("/notifications", reviewHandler.ListNotifications)
+	protected.Post("/notifications/:id/read", reviewHandler.MarkNotificationRead)
+	protected.Post("/drafts", draftHandler.CreateDraft)
+	protected.Get("/drafts", draftHandler.ListDrafts)
+	protected.Get("/drafts/:id", draftHandler.GetDraft)
+	protected.Patch("/drafts/:id", draftHandler.UpdateDraft)
+	protected.Patch("/drafts/:id/parts/:index", draftHandler.UpdateDraftPart)
+	protected.Delete("/drafts/:id", draftHandler.DeleteDraft)
+	protected.Post("/drafts/:id/publish", draftHandler.PublishDraft)
+	protected.Post("/drafts/:id/schedule", draftHandler.ScheduleDraft)
+	protected.Delete("/drafts/:id/schedule", draftHandler.UnscheduleDraft)
+	protected.Get("/insights/best-times", insightsHandler.GetBestTimes)
+	protected.Post("/calendar/token", calendarHandler.CreateCalendarToken)
+	protected.Delete("/calendar/token", calendarHandler.RevokeCalendarToken)
+	protected.Post("/webhooks", webhookHandler.CreateWebhook)
+	protected.Get("/webhooks", webhookHandler.ListWebhooks)
+	protected.Patch("/webhooks/:id", webhookHandler.UpdateWebhook)
+	protected.Delete("/webhooks/:id", webhookHandler.DeleteWebhook)
+	protected.Get("/webhooks/:id/deliveries", webhookHandler.ListDeliveries)
+	protected.Post("/webhooks/:id/test", webhookHandler.SendTestEvent)
 }
@@ -0,0 +1,301 @@
+package app
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+type StoryHandler struct {
+	StoryService     services.StoryService
+	MetricsService   services.MetricsService
+	EvergreenService services.EvergreenService
+	AuthService      services.AuthService
+}
+
+func NewStoryHandler(storyService services.StoryService, metricsService services.MetricsService, evergreenService services.EvergreenService, authService services.AuthService) *StoryHandler {
+	return &StoryHandler{StoryService: storyService, MetricsService: metricsService, EvergreenService: evergreenService, AuthService: authService}
+}
+
+// EditStory godoc
+// @Summary Edit a published post
+// @Description Updates the text of a published LinkedIn post and keeps the previous text as a revision
+// @Tags Stories
+// @Accept json
+// @Produce json
+// @Param id path string true "Social post story ID"
+// @Param input body EditStoryRequest true "New post text"
+// @Success 200 {object} models.SocialPostStories
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 409 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /stories/{id} [patch]
+func (h *StoryHandler) EditStory(c *fiber.Ctx) error {
+	const endpoint = "/stories/:id"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userId := user.ID.Hex()
+
+	storyID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid story ID format")
+	}
+
+	var req EditStoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		log.Logger.Warn("Invalid edit story payload", zap.Error(err), zap.String("userId", userId), zap.String("endpoint", endpoint))
+		return BadRequestError(c, err.Error())
+	}
+
+	if err := ValidateStruct(&req); err != nil {
+		log.Logger.Warn("Edit story validation failed", zap.Error(err), zap.String("userId", userId), zap.String("endpoint", endpoint))
+		return ValidationError(c, err.Error())
+	}
+
+	story, err := h.StoryService.EditPublishedPost(c.Context(), user, storyID, req.Text)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrStoryNotFound):
+			return NotFoundError(c, err.Error())
+		case errors.Is(err, services.ErrStoryNotEditable), errors.Is(err, services.ErrLinkedInNotConnected):
+			return BadRequestError(c, err.Error())
+		case errors.Is(err, services.ErrStoryEditConflict):
+			return ConflictError(c, err.Error())
+		}
+		log.Logger.Error("Failed to edit published post",
+			zap.Error(err),
+			zap.String("userId", userId),
+			zap.String("storyId", storyID.Hex()),
+			zap.String("endpoint", endpoint),
+		)
+		return InternalError(c, "Failed to edit post: "+err.Error())
+	}
+
+	log.Logger.Info("Published post edited",
+		zap.String("userId", userId),
+		zap.String("storyId", storyID.Hex()),
+		zap.Int("revisions", len(story.Revisions)),
+		zap.String("endpoint", endpoint),
+	)
+	return c.JSON(story)
+}
+
+// ListRevisions godoc
+// @Summary List revisions of a published post
+// @Description Returns every version of a published post's text with a word diff against the previous version
+// @Tags Stories
+// @Produce json
+// @Param id path string true "Social post story ID"
+// @Success 200 {object} services.StoryRevisionsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /stories/{id}/revisions [get]
+func (h *StoryHandler) ListRevisions(c *fiber.Ctx) error {
+	const endpoint = "/stories/:id/revisions"
+	user, err := GetUserFromContext(c, h.AuthService)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+
+	storyID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid story ID format")
+	}
+
+	resp, err := h.StoryService.ListRevisions(c.Context(), user.ID, storyID)
+	if err != nil {
+		if errors.Is(err, services.ErrStoryNotFound) {
+			return NotFoundError(c, err.Error())
+		}
+		log.Logger.Error("Failed to list post revisions", zap.Error(err), zap.String("userId", user.ID.Hex()), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(resp)
+}
+
+// SetEvergreen godoc
+// @Summary Mark a published post as evergreen
+// @Description Sets the rules to reshare a published post: minimum days between reshares, maximum number of reshares and whether the text is reworded by AI each time. A background job queues each reshare as a draft scheduled at the next recommended slot; the published reshare is recorded as a new story with originalStoryId set.
+// @Tags Stories
+// @Accept json
+// @Produce json
+// @Param id path string true "Social post story ID"
+// @Param input body EvergreenRequest true "Evergreen rules"
+// @Success 200 {object} models.SocialPostStories
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /stories/{id}/evergreen [put]
+func (h *StoryHandler) SetEvergreen(c *fiber.Ctx) error {
+	const endpoint = "/stories/:id/evergreen"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	storyID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid story ID format")
+	}
+
+	var req EvergreenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	input := services.EvergreenInput{
+		Enabled:        req.Enabled,
+		MinDaysBetween: req.MinDaysBetween,
+		MaxRepetitions: req.MaxRepetitions,
+		Reword:         req.Reword,
+	}
+	story, err := h.EvergreenService.Configure(c.Context(), userObjID, storyID, input)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrStoryNotFound):
+			return NotFoundError(c, err.Error())
+		case errors.Is(err, services.ErrStoryNotEvergreenable):
+			return BadRequestError(c, err.Error())
+		}
+		log.Logger.Error("Failed to set evergreen settings", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(story)
+}
+
+// ListReshares godoc
+// @Summary List reshares of an evergreen post
+// @Description Returns the stories published as reshares of an evergreen post, oldest first
+// @Tags Stories
+// @Produce json
+// @Param id path string true "Social post story ID"
+// @Success 200 {array} models.SocialPostStories
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /stories/{id}/reshares [get]
+func (h *StoryHandler) ListReshares(c *fiber.Ctx) error {
+	const endpoint = "/stories/:id/reshares"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	storyID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid story ID format")
+	}
+
+	reshares, err := h.EvergreenService.ListReshares(c.Context(), userObjID, storyID)
+	if err != nil {
+		if errors.Is(err, services.ErrStoryNotFound) {
+			return NotFoundError(c, err.Error())
+		}
+		log.Logger.Error("Failed to list reshares", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(reshares)
+}
+
+// GetMetrics godoc
+// @Summary Get engagement metrics of a published post
+// @Description Returns the latest reactions, comments and shares of a published post and their time series, along with its short links and their clicks
+// @Tags Stories
+// @Produce json
+// @Param id path string true "Social post story ID"
+// @Success 200 {object} services.StoryMetricsResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /stories/{id}/metrics [get]
+func (h *StoryHandler) GetMetrics(c *fiber.Ctx) error {
+	const endpoint = "/stories/:id/metrics"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	storyID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid story ID format")
+	}
+
+	resp, err := h.MetricsService.GetStoryMetrics(c.Context(), userObjID, storyID)
+	if err != nil {
+		if errors.Is(err, services.ErrStoryNotFound) {
+			return NotFoundError(c, err.Error())
+		}
+		log.Logger.Error("Failed to get post metrics", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(resp)
+}
+
+// GetMetricsSummary godoc
+// @Summary Get engagement summary of the user's published posts
+// @Description Returns engagement totals, short link clicks, average engagement per post and the top performing posts
+// @Tags Stories
+// @Produce json
+// @Success 200 {object} services.MetricsSummary
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /stories/metrics/summary [get]
+func (h *StoryHandler) GetMetricsSummary(c *fiber.Ctx) error {
+	const endpoint = "/stories/metrics/summary"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	summary, err := h.MetricsService.GetUserSummary(c.Context(), userObjID)
+	if err != nil {
+		log.Logger.Error("Failed to get metrics summary", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+		return InternalError(c, err.Error())
+	}
+
+	return c.JSON(summary)
+}
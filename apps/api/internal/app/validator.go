@@ -3,8 +3,11 @@ package app
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/services"
 )
 
 var validate *validator.Validate
@@ -15,15 +18,10 @@ func init() {
 	validate.RegisterValidation("datasourcetype", validateDataSourceType)
 }
 
+// validateDataSourceType accepts the types registered as article sources
 func validateDataSourceType(fl validator.FieldLevel) bool {
-	value := fl.Field().String()
-	validTypes := []string{"rss", "devto", "hackernews"}
-	for _, t := range validTypes {
-		if value == t {
-			return true
-		}
-	}
-	return false
+	_, ok := services.LookupArticleSource(models.DataSourceType(fl.Field().String()))
+	return ok
 }
 
 // ValidateStruct validates a struct and returns a formatted error message
@@ -64,6 +62,8 @@ func formatValidationError(e validator.FieldError) string {
 		return fmt.Sprintf("%s must be one of: rss, devto, hackernews", field)
 	case "oneof":
 		return fmt.Sprintf("%s must be one of: %s", field, e.Param())
+	case "unique":
+		return fmt.Sprintf("%s must not contain duplicates", field)
 	default:
 		return fmt.Sprintf("%s failed on %s validation", field, e.Tag())
 	}
@@ -94,23 +94,174 @@ type RefreshTokenRequest struct {
 	RefreshToken string `json:"refreshToken" validate:"required"`
 }
 
+// DataSourceRequest is a data source of the user. Type is one of the types listed by GET /sources/types,
+// and Url is required or not depending on it.
 type DataSourceRequest struct {
 	Type string   `json:"type" validate:"required,datasourcetype"`
-	Url  string   `json:"url" validate:"required,url"`
+	Url  string   `json:"url" validate:"omitempty,url"`
 	Tags []string `json:"tags" validate:"omitempty,dive,min=1,max=50"`
+	// Listing, Window, MinScore and SkipSelfPosts configure reddit sources
+	Listing       string `json:"listing" validate:"omitempty,oneof=hot top new"`
+	Window        string `json:"window" validate:"omitempty,oneof=hour day week month year all"`
+	MinScore      int    `json:"minScore" validate:"omitempty,min=0,max=100000"`
+	SkipSelfPosts bool   `json:"skipSelfPosts"`
 }
 
 type UpdateProfileRequest struct {
 	OpenAiApiKey string              `json:"openAiApiKey" validate:"omitempty"`
 	OpenAiModel  string              `json:"openAiModel" validate:"omitempty,oneof=gpt-3.5-turbo gpt-4 gpt-4-turbo gpt-4o"`
+	DevToApiKey  string              `json:"devToApiKey" validate:"omitempty,max=128"`
 	DataSources  []DataSourceRequest `json:"dataSources" validate:"omitempty,dive"`
 }
 
 type GeneratePostRequest struct {
 	Topic string `json:"topic" validate:"required,min=3,max=2000"`
+	Parts int    `json:"parts" validate:"omitempty,min=2,max=10"`
 }
 
 type PublishLinkedInPostRequest struct {
 	Text      string `json:"text" validate:"required,min=1,max=3000"`
 	PostLogID string `json:"postLogId" validate:"omitempty"`
 }
+
+type PublishTargetRequest struct {
+	Network  string `json:"network" validate:"required,oneof=linkedin"`
+	Text     string `json:"text" validate:"omitempty,max=3000"`
+	Campaign string `json:"campaign" validate:"omitempty,max=100"`
+}
+
+type PublishPostRequest struct {
+	Targets []PublishTargetRequest `json:"targets" validate:"required,min=1,unique=Network,dive"`
+}
+
+type EditStoryRequest struct {
+	Text string `json:"text" validate:"required,min=1,max=3000"`
+}
+
+type EvergreenRequest struct {
+	Enabled        bool `json:"enabled"`
+	MinDaysBetween int  `json:"minDaysBetween" validate:"min=1,max=365"`
+	MaxRepetitions int  `json:"maxRepetitions" validate:"min=1,max=52"`
+	Reword         bool `json:"reword"`
+}
+
+type UpdateCommentRequest struct {
+	Status string `json:"status" validate:"required,oneof=new read"`
+}
+
+type ReplyCommentRequest struct {
+	Text string `json:"text" validate:"required,min=1,max=1250"`
+}
+
+type UpdateReviewSettingsRequest struct {
+	Enabled        bool     `json:"enabled"`
+	ReviewerEmails []string `json:"reviewerEmails" validate:"max=10,dive,email"`
+}
+
+type ReviewActionRequest struct {
+	Action  string `json:"action" validate:"required,oneof=submit approve request_changes"`
+	Comment string `json:"comment" validate:"max=2000"`
+}
+
+type DraftArticleRequest struct {
+	URL   string `json:"url" validate:"required,url"`
+	Title string `json:"title" validate:"omitempty,max=300"`
+}
+
+type PostPartRequest struct {
+	Title string `json:"title" validate:"omitempty,max=120"`
+	Text  string `json:"text" validate:"required,min=1,max=1000"`
+}
+
+type CreateDraftRequest struct {
+	PostLogID string               `json:"postLogId" validate:"omitempty,len=24,hexadecimal"`
+	Article   *DraftArticleRequest `json:"article" validate:"omitempty"`
+	Title     string               `json:"title" validate:"omitempty,max=200"`
+	Text      string               `json:"text" validate:"omitempty,max=3000"`
+	Parts     []PostPartRequest    `json:"parts" validate:"omitempty,max=20,dive"`
+	Theme     string               `json:"theme" validate:"omitempty,oneof=light dark ocean"`
+}
+
+// UpdateDraftRequest only changes the fields that are present, so clients can autosave partial edits
+type UpdateDraftRequest struct {
+	Version int64              `json:"version" validate:"min=1"`
+	Title   *string            `json:"title" validate:"omitempty,max=200"`
+	Text    *string            `json:"text" validate:"omitempty,max=3000"`
+	Parts   *[]PostPartRequest `json:"parts" validate:"omitempty,max=20,dive"`
+	Theme   *string            `json:"theme" validate:"omitempty,oneof=light dark ocean"`
+}
+
+// DraftVersionRequest carries the version of a draft for actions that have no other input
+type DraftVersionRequest struct {
+	Version int64 `json:"version" validate:"min=1"`
+}
+
+const ScheduleSlotNextRecommended = "next_recommended"
+
+// ScheduleDraftRequest takes either an exact time or slot=next_recommended
+type ScheduleDraftRequest struct {
+	Version  int64                  `json:"version" validate:"min=1"`
+	At       *time.Time             `json:"at" validate:"required_without=Slot"`
+	Slot     string                 `json:"slot" validate:"omitempty,oneof=next_recommended"`
+	TimeZone string                 `json:"timeZone" validate:"omitempty,timezone"`
+	Targets  []PublishTargetRequest `json:"targets" validate:"required,min=1,unique=Network,dive"`
+}
+
+type UpdateDraftPartRequest struct {
+	Version int64   `json:"version" validate:"min=1"`
+	Title   *string `json:"title" validate:"omitempty,max=120"`
+	Text    *string `json:"text" validate:"omitempty,min=1,max=1000"`
+}
+
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" validate:"required,http_url,max=2000"`
+	Description string   `json:"description" validate:"omitempty,max=200"`
+	Events      []string `json:"events" validate:"omitempty,unique,dive,oneof=post.generated post.published post.failed post.deleted linkedin.connection_expired"`
+}
+
+type UpdateWebhookRequest struct {
+	URL         *string   `json:"url" validate:"omitempty,http_url,max=2000"`
+	Description *string   `json:"description" validate:"omitempty,max=200"`
+	Events      *[]string `json:"events" validate:"omitempty,unique,dive,oneof=post.generated post.published post.failed post.deleted linkedin.connection_expired"`
+	Active      *bool     `json:"active"`
+}
+
+type PreviewPostRequest struct {
+	Text     string   `json:"text" validate:"required,min=1,max=10000"`
+	Networks []string `json:"networks" validate:"omitempty,unique,dive,oneof=linkedin x"`
+}
+
+// DevToArticleRequest is the content of a dev.to article; dev.to accepts up to 4 tags
+type DevToArticleRequest struct {
+	PostLogID    string   `json:"postLogId" validate:"omitempty,len=24,hexadecimal"`
+	Title        string   `json:"title" validate:"required,min=1,max=128"`
+	BodyMarkdown string   `json:"bodyMarkdown" validate:"required,min=1,max=100000"`
+	Tags         []string `json:"tags" validate:"omitempty,max=4,unique,dive,min=1,max=30,alphanum"`
+	CanonicalURL string   `json:"canonicalUrl" validate:"omitempty,url"`
+	Published    bool     `json:"published"`
+}
+
+type BlogConnectionRequest struct {
+	Platform            string `json:"platform" validate:"required,oneof=wordpress ghost"`
+	SiteURL             string `json:"siteUrl" validate:"required,url,max=2000"`
+	Username            string `json:"username" validate:"omitempty,max=200"`
+	ApplicationPassword string `json:"applicationPassword" validate:"omitempty,max=200"`
+	AdminAPIKey         string `json:"adminApiKey" validate:"omitempty,max=200"`
+}
+
+type UpdateBlogConnectionRequest struct {
+	SiteURL             string `json:"siteUrl" validate:"required,url,max=2000"`
+	Username            string `json:"username" validate:"omitempty,max=200"`
+	ApplicationPassword string `json:"applicationPassword" validate:"omitempty,max=200"`
+	AdminAPIKey         string `json:"adminApiKey" validate:"omitempty,max=200"`
+}
+
+type BlogPostRequest struct {
+	PostLogID       string   `json:"postLogId"`
+	Title           string   `json:"title" validate:"required,min=1,max=255"`
+	Content         string   `json:"content" validate:"required_without=PostLogID,max=200000"`
+	ContentFormat   string   `json:"contentFormat" validate:"omitempty,oneof=text html"`
+	Tags            []string `json:"tags" validate:"omitempty,max=20,unique,dive,min=1,max=100"`
+	FeatureImageURL string   `json:"featureImageUrl" validate:"omitempty,url,max=2000"`
+	Published       bool     `json:"published"`
+}
@@ -0,0 +1,272 @@
+package app
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/services"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+type WebhookHandler struct {
+	WebhookService services.WebhookService
+}
+
+func NewWebhookHandler(webhookService services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{WebhookService: webhookService}
+}
+
+// webhookCreatedResponse is returned once on creation, the only time the signing secret is shown
+type webhookCreatedResponse struct {
+	*models.Webhook
+	Secret string `json:"secret"`
+}
+
+// CreateWebhook godoc
+// @Summary Register a webhook endpoint
+// @Description Registers a URL that receives post and account events as signed JSON. The X-PostPilot-Signature header carries "sha256=" followed by the hex HMAC-SHA256 of "{X-PostPilot-Timestamp}.{body}", keyed with the secret returned here only once. Without events, every event is sent. Only http(s) URLs of public hosts are accepted.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param input body CreateWebhookRequest true "Webhook endpoint"
+// @Success 201 {object} webhookCreatedResponse
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *fiber.Ctx) error {
+	const endpoint = "/webhooks"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	var req CreateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	webhook, err := h.WebhookService.Create(c.Context(), userObjID, services.WebhookInput{
+		URL:         req.URL,
+		Description: req.Description,
+		Events:      convertWebhookEvents(req.Events),
+	})
+	if err != nil {
+		return h.handleWebhookError(c, err, userID, endpoint)
+	}
+	return c.Status(http.StatusCreated).JSON(webhookCreatedResponse{Webhook: webhook, Secret: webhook.Secret})
+}
+
+// ListWebhooks godoc
+// @Summary List webhook endpoints
+// @Tags Webhooks
+// @Produce json
+// @Success 200 {array} models.Webhook
+// @Failure 401 {object} map[string]interface{}
+// @Failure 500 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /webhooks [get]
+func (h *WebhookHandler) ListWebhooks(c *fiber.Ctx) error {
+	const endpoint = "/webhooks"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	webhooks, err := h.WebhookService.List(c.Context(), userObjID)
+	if err != nil {
+		return h.handleWebhookError(c, err, userID, endpoint)
+	}
+	return c.JSON(webhooks)
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook endpoint
+// @Description Changes the URL, description, event filter or active flag. Only the fields sent are changed.
+// @Tags Webhooks
+// @Accept json
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param input body UpdateWebhookRequest true "Fields to change"
+// @Success 200 {object} models.Webhook
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Failure 422 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /webhooks/{id} [patch]
+func (h *WebhookHandler) UpdateWebhook(c *fiber.Ctx) error {
+	const endpoint = "/webhooks/:id"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	webhookID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid webhook ID format")
+	}
+
+	var req UpdateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return BadRequestError(c, err.Error())
+	}
+	if err := ValidateStruct(&req); err != nil {
+		return ValidationError(c, err.Error())
+	}
+
+	patch := services.WebhookPatch{URL: req.URL, Description: req.Description, Active: req.Active}
+	if req.Events != nil {
+		events := convertWebhookEvents(*req.Events)
+		patch.Events = &events
+	}
+
+	webhook, err := h.WebhookService.Update(c.Context(), userObjID, webhookID, patch)
+	if err != nil {
+		return h.handleWebhookError(c, err, userID, endpoint)
+	}
+	return c.JSON(webhook)
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook endpoint
+// @Description Deletes the endpoint and its delivery log
+// @Tags Webhooks
+// @Param id path string true "Webhook ID"
+// @Success 204
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *fiber.Ctx) error {
+	const endpoint = "/webhooks/:id"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	webhookID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid webhook ID format")
+	}
+
+	if err := h.WebhookService.Delete(c.Context(), userObjID, webhookID); err != nil {
+		return h.handleWebhookError(c, err, userID, endpoint)
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// ListDeliveries godoc
+// @Summary List the deliveries of a webhook
+// @Description Returns the events sent to the endpoint, newest first, with attempts, last response status and next retry
+// @Tags Webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Param limit query int false "Max deliveries (default 50, max 200)"
+// @Success 200 {array} models.WebhookDelivery
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) ListDeliveries(c *fiber.Ctx) error {
+	const endpoint = "/webhooks/:id/deliveries"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	webhookID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid webhook ID format")
+	}
+
+	deliveries, err := h.WebhookService.ListDeliveries(c.Context(), userObjID, webhookID, parseListLimit(c))
+	if err != nil {
+		return h.handleWebhookError(c, err, userID, endpoint)
+	}
+	return c.JSON(deliveries)
+}
+
+// SendTestEvent godoc
+// @Summary Send a test event to a webhook
+// @Description Sends a signed webhook.test event right away and returns the delivery with the response status. Test events are not retried.
+// @Tags Webhooks
+// @Produce json
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} models.WebhookDelivery
+// @Failure 400 {object} map[string]interface{}
+// @Failure 401 {object} map[string]interface{}
+// @Failure 404 {object} map[string]interface{}
+// @Security BearerAuth
+// @Router /webhooks/{id}/test [post]
+func (h *WebhookHandler) SendTestEvent(c *fiber.Ctx) error {
+	const endpoint = "/webhooks/:id/test"
+	userID, err := GetUserIDFromContext(c)
+	if err != nil {
+		return HandleUserContextError(c, err, endpoint)
+	}
+	userObjID, err := primitive.ObjectIDFromHex(userID)
+	if err != nil {
+		return BadRequestError(c, "Invalid user ID")
+	}
+
+	webhookID, err := primitive.ObjectIDFromHex(c.Params("id"))
+	if err != nil {
+		return BadRequestError(c, "Invalid webhook ID format")
+	}
+
+	delivery, err := h.WebhookService.SendTest(c.Context(), userObjID, webhookID)
+	if err != nil {
+		return h.handleWebhookError(c, err, userID, endpoint)
+	}
+	return c.JSON(delivery)
+}
+
+func (h *WebhookHandler) handleWebhookError(c *fiber.Ctx, err error, userID, endpoint string) error {
+	switch {
+	case errors.Is(err, services.ErrWebhookNotFound):
+		return NotFoundError(c, err.Error())
+	case errors.Is(err, services.ErrInvalidWebhookURL):
+		return ValidationError(c, err.Error())
+	}
+	log.Logger.Error("Webhook request failed", zap.Error(err), zap.String("userId", userID), zap.String("endpoint", endpoint))
+	return InternalError(c, err.Error())
+}
+
+func convertWebhookEvents(events []string) []models.WebhookEvent {
+	result := make([]models.WebhookEvent, len(events))
+	for i, e := range events {
+		result[i] = models.WebhookEvent(e)
+	}
+	return result
+}
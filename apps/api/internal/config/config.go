@@ -15,6 +15,11 @@ type Config struct {
 	LinkedIn  LinkedInConfig
 	Google    GoogleConfig
 	Frontend  FrontendConfig
+	Jobs      JobsConfig
+	Carousel  CarouselConfig
+	Webhooks  WebhooksConfig
+	Links     LinksConfig
+	Feeds     FeedsConfig
 }
 
 // ServerConfig holds server configuration
@@ -55,6 +60,8 @@ type LinkedInConfig struct {
 	ClientSecret       string
 	RedirectURI        string
 	PublishRedirectURI string
+	APIVersion         string
+	UsePostsAPI        bool
 }
 
 // GoogleConfig holds Google OAuth configuration
@@ -69,6 +76,47 @@ type FrontendConfig struct {
 	URL string
 }
 
+// JobsConfig holds background job configuration
+type JobsConfig struct {
+	MetricsSyncInterval      time.Duration
+	MetricsSyncBatchSize     int
+	CommentsSyncInterval     time.Duration
+	CommentsSyncLookback     time.Duration
+	LinkedInCheckInterval    time.Duration
+	LinkedInRefreshWindow    time.Duration
+	WebhookRetryInterval     time.Duration
+	ScheduledPublishInterval time.Duration
+	EvergreenInterval        time.Duration
+	ArticlesIngestInterval   time.Duration
+}
+
+// CarouselConfig holds the rendering defaults of LinkedIn document carousels
+type CarouselConfig struct {
+	Theme string
+}
+
+// WebhooksConfig holds outbound webhook delivery configuration
+type WebhooksConfig struct {
+	Timeout        time.Duration
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+}
+
+// LinksConfig holds link tracking configuration: UTM tagging and the built-in shortener
+type LinksConfig struct {
+	UTMEnabled       bool
+	UTMMedium        string
+	UTMCampaign      string
+	ShortenerEnabled bool
+	ShortLinkBaseURL string
+}
+
+// FeedsConfig holds the cache of the articles read from data sources
+type FeedsConfig struct {
+	CacheTTL       time.Duration
+	CacheRetention time.Duration
+}
+
 var cfg *Config
 
 // Load loads configuration from environment variables
@@ -107,6 +155,8 @@ func Load() *Config {
 			ClientSecret:       getEnv("LINKEDIN_CLIENT_SECRET", ""),
 			RedirectURI:        getEnv("LINKEDIN_REDIRECT_URI", ""),
 			PublishRedirectURI: getEnv("LINKEDIN_PUBLISH_REDIRECT_URI", ""),
+			APIVersion:         getEnv("LINKEDIN_API_VERSION", "202405"),
+			UsePostsAPI:        getBoolEnv("LINKEDIN_USE_POSTS_API", false),
 		},
 		Google: GoogleConfig{
 			ClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
@@ -116,6 +166,37 @@ func Load() *Config {
 		Frontend: FrontendConfig{
 			URL: getEnv("FRONT_END_URL", "http://localhost:3000"),
 		},
+		Jobs: JobsConfig{
+			MetricsSyncInterval:      getDurationEnv("METRICS_SYNC_INTERVAL", 15*time.Minute),
+			MetricsSyncBatchSize:     getIntEnv("METRICS_SYNC_BATCH_SIZE", 100),
+			CommentsSyncInterval:     getDurationEnv("COMMENTS_SYNC_INTERVAL", 10*time.Minute),
+			CommentsSyncLookback:     getDurationEnv("COMMENTS_SYNC_LOOKBACK", 30*24*time.Hour),
+			LinkedInCheckInterval:    getDurationEnv("LINKEDIN_TOKEN_CHECK_INTERVAL", 6*time.Hour),
+			LinkedInRefreshWindow:    getDurationEnv("LINKEDIN_TOKEN_REFRESH_WINDOW", 7*24*time.Hour),
+			WebhookRetryInterval:     getDurationEnv("WEBHOOK_RETRY_INTERVAL", 30*time.Second),
+			ScheduledPublishInterval: getDurationEnv("SCHEDULED_PUBLISH_INTERVAL", time.Minute),
+			EvergreenInterval:        getDurationEnv("EVERGREEN_INTERVAL", time.Hour),
+			ArticlesIngestInterval:   getDurationEnv("ARTICLES_INGEST_INTERVAL", 15*time.Minute),
+		},
+		Carousel: CarouselConfig{
+			Theme: getEnv("CAROUSEL_THEME", "light"),
+		},
+		Webhooks: WebhooksConfig{
+			Timeout:        getDurationEnv("WEBHOOK_TIMEOUT", 10*time.Second),
+			MaxAttempts:    getIntEnv("WEBHOOK_MAX_ATTEMPTS", 6),
+			RetryBaseDelay: getDurationEnv("WEBHOOK_RETRY_BASE_DELAY", time.Minute),
+		},
+		Links: LinksConfig{
+			UTMEnabled:       getBoolEnv("LINK_UTM_ENABLED", false),
+			UTMMedium:        getEnv("LINK_UTM_MEDIUM", "social"),
+			UTMCampaign:      getEnv("LINK_UTM_CAMPAIGN", "postpilot"),
+			ShortenerEnabled: getBoolEnv("LINK_SHORTENER_ENABLED", false),
+			ShortLinkBaseURL: getEnv("SHORT_LINK_BASE_URL", "http://localhost:8081/l"),
+		},
+		Feeds: FeedsConfig{
+			CacheTTL:       getDurationEnv("FEED_CACHE_TTL", 15*time.Minute),
+			CacheRetention: getDurationEnv("FEED_CACHE_RETENTION", 24*time.Hour),
+		},
 	}
 
 	return cfg
@@ -172,6 +253,16 @@ func getIntEnv(key string, defaultValue int) int {
 	return defaultValue
 }
 
+// getBoolEnv gets a boolean from an environment variable or returns a default value
+func getBoolEnv(key string, defaultValue bool) bool {
+	if value, exists := os.LookupEnv(key); exists {
+		if boolVal, err := strconv.ParseBool(value); err == nil {
+			return boolVal
+		}
+	}
+	return defaultValue
+}
+
 // getUint64Env gets a uint64 from an environment variable or returns a default value
 func getUint64Env(key string, defaultValue uint64) uint64 {
 	if value, exists := os.LookupEnv(key); exists {
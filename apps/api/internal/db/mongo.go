@@ -108,6 +108,46 @@ func EnsureIndexes(ctx context.Context) error {
 		return err
 	}
 
+	if err := createPostMetricsIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createPostCommentsIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createNotificationsIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createDraftsIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createWebhooksIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createLinksIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createPostImportsIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createBlogConnectionsIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createFeedCacheIndexes(ctx, db); err != nil {
+		return err
+	}
+
+	if err := createArticlesIndexes(ctx, db); err != nil {
+		return err
+	}
+
 	log.Logger.Info("MongoDB indexes created successfully")
 	return nil
 }
@@ -124,6 +164,14 @@ func createUsersIndexes(ctx context.Context, db *mongo.Database) error {
 			Keys:    bson.D{{Key: "provider", Value: 1}, {Key: "providerId", Value: 1}},
 			Options: options.Index().SetUnique(true).SetSparse(true).SetName("idx_users_provider_providerId_unique"),
 		},
+		{
+			Keys:    bson.D{{Key: "review.reviewerIds", Value: 1}},
+			Options: options.Index().SetSparse(true).SetName("idx_users_review_reviewerIds"),
+		},
+		{
+			Keys:    bson.D{{Key: "calendarTokenHash", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true).SetName("idx_users_calendarTokenHash_unique"),
+		},
 	}
 
 	for _, index := range indexes {
@@ -165,6 +213,10 @@ func createPostGenerationLogsIndexes(ctx context.Context, db *mongo.Database) er
 			Keys:    bson.D{{Key: "createdAt", Value: -1}},
 			Options: options.Index().SetName("idx_post_generation_logs_createdAt"),
 		},
+		{
+			Keys:    bson.D{{Key: "reviewStatus", Value: 1}, {Key: "userId", Value: 1}, {Key: "createdAt", Value: 1}},
+			Options: options.Index().SetSparse(true).SetName("idx_post_generation_logs_reviewStatus_userId_createdAt"),
+		},
 	}
 
 	_, err := collection.Indexes().CreateMany(ctx, indexes)
@@ -197,6 +249,22 @@ func createSocialPostStoriesIndexes(ctx context.Context, db *mongo.Database) err
 			Keys:    bson.D{{Key: "externalPostId", Value: 1}},
 			Options: options.Index().SetSparse(true).SetName("idx_social_post_stories_externalPostId"),
 		},
+		{
+			Keys:    bson.D{{Key: "postGenerationLogId", Value: 1}, {Key: "network", Value: 1}},
+			Options: options.Index().SetName("idx_social_post_stories_postGenerationLogId_network"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "nextMetricsSyncAt", Value: 1}},
+			Options: options.Index().SetName("idx_social_post_stories_status_nextMetricsSyncAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "evergreen.enabled", Value: 1}, {Key: "evergreen.nextEligibleAt", Value: 1}},
+			Options: options.Index().SetSparse(true).SetName("idx_social_post_stories_evergreen_nextEligibleAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "originalStoryId", Value: 1}},
+			Options: options.Index().SetSparse(true).SetName("idx_social_post_stories_originalStoryId"),
+		},
 	}
 
 	_, err := collection.Indexes().CreateMany(ctx, indexes)
@@ -209,6 +277,160 @@ func createSocialPostStoriesIndexes(ctx context.Context, db *mongo.Database) err
 	return nil
 }
 
+func createPostMetricsIndexes(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection("post_metrics")
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "storyId", Value: 1}, {Key: "collectedAt", Value: 1}},
+			Options: options.Index().SetName("idx_post_metrics_storyId_collectedAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "collectedAt", Value: -1}},
+			Options: options.Index().SetName("idx_post_metrics_userId_collectedAt"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Logger.Error("Failed to create post_metrics indexes", zap.Error(err))
+		return fmt.Errorf("failed to create post_metrics indexes: %w", err)
+	}
+
+	log.Logger.Debug("Post metrics indexes created")
+	return nil
+}
+
+func createPostCommentsIndexes(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection("post_comments")
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "externalCommentId", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_post_comments_externalCommentId_unique"),
+		},
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "status", Value: 1}, {Key: "commentedAt", Value: -1}},
+			Options: options.Index().SetName("idx_post_comments_userId_status_commentedAt"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Logger.Error("Failed to create post_comments indexes", zap.Error(err))
+		return fmt.Errorf("failed to create post_comments indexes: %w", err)
+	}
+
+	log.Logger.Debug("Post comments indexes created")
+	return nil
+}
+
+func createNotificationsIndexes(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection("notifications")
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "read", Value: 1}, {Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_notifications_userId_read_createdAt"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Logger.Error("Failed to create notifications indexes", zap.Error(err))
+		return fmt.Errorf("failed to create notifications indexes: %w", err)
+	}
+
+	log.Logger.Debug("Notifications indexes created")
+	return nil
+}
+
+func createDraftsIndexes(ctx context.Context, db *mongo.Database) error {
+	collection := db.Collection("drafts")
+
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "status", Value: 1}, {Key: "updatedAt", Value: -1}},
+			Options: options.Index().SetName("idx_drafts_userId_status_updatedAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "scheduledAt", Value: 1}},
+			Options: options.Index().SetName("idx_drafts_status_scheduledAt"),
+		},
+	}
+
+	_, err := collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		log.Logger.Error("Failed to create drafts indexes", zap.Error(err))
+		return fmt.Errorf("failed to create drafts indexes: %w", err)
+	}
+
+	log.Logger.Debug("Drafts indexes created")
+	return nil
+}
+
+func createWebhooksIndexes(ctx context.Context, db *mongo.Database) error {
+	webhooks := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "active", Value: 1}},
+			Options: options.Index().SetName("idx_webhooks_userId_active"),
+		},
+	}
+	if _, err := db.Collection("webhooks").Indexes().CreateMany(ctx, webhooks); err != nil {
+		log.Logger.Error("Failed to create webhooks indexes", zap.Error(err))
+		return fmt.Errorf("failed to create webhooks indexes: %w", err)
+	}
+
+	deliveries := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "webhookId", Value: 1}, {Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_webhook_deliveries_webhookId_createdAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "status", Value: 1}, {Key: "nextAttemptAt", Value: 1}},
+			Options: options.Index().SetName("idx_webhook_deliveries_status_nextAttemptAt"),
+		},
+	}
+	if _, err := db.Collection("webhook_deliveries").Indexes().CreateMany(ctx, deliveries); err != nil {
+		log.Logger.Error("Failed to create webhook_deliveries indexes", zap.Error(err))
+		return fmt.Errorf("failed to create webhook_deliveries indexes: %w", err)
+	}
+
+	log.Logger.Debug("Webhooks indexes created")
+	return nil
+}
+
+func createLinksIndexes(ctx context.Context, db *mongo.Database) error {
+	links := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "code", Value: 1}},
+			Options: options.Index().SetUnique(true).SetName("idx_short_links_code_unique"),
+		},
+		{
+			Keys:    bson.D{{Key: "storyId", Value: 1}},
+			Options: options.Index().SetName("idx_short_links_storyId"),
+		},
+	}
+	if _, err := db.Collection("short_links").Indexes().CreateMany(ctx, links); err != nil {
+		log.Logger.Error("Failed to create short_links indexes", zap.Error(err))
+		return fmt.Errorf("failed to create short_links indexes: %w", err)
+	}
+
+	clicks := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "linkId", Value: 1}, {Key: "clickedAt", Value: -1}},
+			Options: options.Index().SetName("idx_link_clicks_linkId_clickedAt"),
+		},
+	}
+	if _, err := db.Collection("link_clicks").Indexes().CreateMany(ctx, clicks); err != nil {
+		log.Logger.Error("Failed to create link_clicks indexes", zap.Error(err))
+		return fmt.Errorf("failed to create link_clicks indexes: %w", err)
+	}
+
+	log.Logger.Debug("Links indexes created")
+	return nil
+}
+
 // HealthCheck performs a health check on the MongoDB connection
 func HealthCheck(ctx context.Context) error {
 	client, err := GetMongoClient()
@@ -230,3 +452,99 @@ func isDuplicateKeyError(err error) bool {
 	return strings.Contains(err.Error(), "DuplicateKey") ||
 		strings.Contains(err.Error(), "E11000")
 }
+
+func createPostImportsIndexes(ctx context.Context, db *mongo.Database) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "createdAt", Value: -1}},
+			Options: options.Index().SetName("idx_post_imports_userId_createdAt"),
+		},
+	}
+	if _, err := db.Collection("post_imports").Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Logger.Error("Failed to create post_imports indexes", zap.Error(err))
+		return fmt.Errorf("failed to create post_imports indexes: %w", err)
+	}
+
+	log.Logger.Debug("Post imports indexes created")
+	return nil
+}
+
+func createBlogConnectionsIndexes(ctx context.Context, db *mongo.Database) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "userId", Value: 1}, {Key: "createdAt", Value: 1}},
+			Options: options.Index().SetName("idx_blog_connections_userId_createdAt"),
+		},
+	}
+	if _, err := db.Collection("blog_connections").Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Logger.Error("Failed to create blog_connections indexes", zap.Error(err))
+		return fmt.Errorf("failed to create blog_connections indexes: %w", err)
+	}
+
+	log.Logger.Debug("Blog connections indexes created")
+	return nil
+}
+
+func createFeedCacheIndexes(ctx context.Context, db *mongo.Database) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+			Options: options.Index().SetName("idx_feed_cache_expiresAt_ttl").SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := db.Collection("feed_cache").Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Logger.Error("Failed to create feed_cache indexes", zap.Error(err))
+		return fmt.Errorf("failed to create feed_cache indexes: %w", err)
+	}
+
+	log.Logger.Debug("Feed cache indexes created")
+	return nil
+}
+
+func createArticlesIndexes(ctx context.Context, db *mongo.Database) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "sourceKey", Value: 1}, {Key: "canonicalUrl", Value: 1}},
+			Options: options.Index().SetName("idx_articles_sourceKey_canonicalUrl_unique").SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "sourceKey", Value: 1}, {Key: "publishedAt", Value: -1}},
+			Options: options.Index().SetName("idx_articles_sourceKey_publishedAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "tags", Value: 1}},
+			Options: options.Index().SetName("idx_articles_tags"),
+		},
+		{
+			Keys:    bson.D{{Key: "url", Value: 1}},
+			Options: options.Index().SetName("idx_articles_url"),
+		},
+		{
+			Keys:    bson.D{{Key: "canonicalUrl", Value: 1}},
+			Options: options.Index().SetName("idx_articles_canonicalUrl"),
+		},
+		{
+			Keys:    bson.D{{Key: "publishedAt", Value: -1}},
+			Options: options.Index().SetName("idx_articles_publishedAt"),
+		},
+		{
+			Keys:    bson.D{{Key: "clusterId", Value: 1}, {Key: "firstSeenAt", Value: 1}},
+			Options: options.Index().SetName("idx_articles_clusterId_firstSeenAt"),
+		},
+		{
+			Keys: bson.D{{Key: "title", Value: "text"}, {Key: "tags", Value: "text"}, {Key: "summary", Value: "text"}},
+			Options: options.Index().
+				SetName("idx_articles_text").
+				SetWeights(bson.M{"title": 10, "tags": 5, "summary": 1}).
+				SetDefaultLanguage("english").
+				SetLanguageOverride("language"),
+		},
+	}
+	if _, err := db.Collection("articles").Indexes().CreateMany(ctx, indexes); err != nil {
+		log.Logger.Error("Failed to create articles indexes", zap.Error(err))
+		return fmt.Errorf("failed to create articles indexes: %w", err)
+	}
+
+	log.Logger.Debug("Articles indexes created")
+	return nil
+}
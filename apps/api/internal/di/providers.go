@@ -6,8 +6,10 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 
 	appPkg "github.com/postpilot/api/internal/app"
+	"github.com/postpilot/api/internal/config"
 	"github.com/postpilot/api/internal/db"
 	"github.com/postpilot/api/internal/httpclient"
+	"github.com/postpilot/api/internal/jobs"
 	"github.com/postpilot/api/internal/repositories"
 	"github.com/postpilot/api/internal/services"
 )
@@ -23,6 +25,19 @@ var RepositorySet = wire.NewSet(
 	repositories.NewUserRepositoryWithDB,
 	repositories.NewPostGenerationLogRepositoryWithDB,
 	repositories.NewSocialPostStoriesRepositoryWithDB,
+	repositories.NewPostMetricsRepositoryWithDB,
+	repositories.NewPostCommentRepositoryWithDB,
+	repositories.NewNotificationRepositoryWithDB,
+	repositories.NewDraftRepositoryWithDB,
+	repositories.NewWebhookRepositoryWithDB,
+	repositories.NewWebhookDeliveryRepositoryWithDB,
+	repositories.NewShortLinkRepositoryWithDB,
+	repositories.NewLinkClickRepositoryWithDB,
+	repositories.NewPostImportRepositoryWithDB,
+	repositories.NewBlogConnectionRepositoryWithDB,
+	repositories.NewFeedCacheRepositoryWithDB,
+	repositories.NewArticleRepositoryWithDB,
+	repositories.NewJobLeaseRepositoryWithDB,
 )
 
 // ServiceSet provides all services
@@ -30,7 +45,27 @@ var ServiceSet = wire.NewSet(
 	ProvideAuthService,
 	services.NewArticleService,
 	ProvideOpenAIClient,
+	ProvideLinkedInClient,
+	ProvideDevToClient,
+	ProvideWordPressClient,
+	ProvideGhostClient,
 	ProvidePostService,
+	services.NewStoryService,
+	services.NewMetricsService,
+	services.NewCommentService,
+	services.NewLinkedInConnectionService,
+	services.NewNotificationService,
+	services.NewReviewService,
+	services.NewDraftService,
+	services.NewWebhookService,
+	services.NewPreviewService,
+	services.NewInsightsService,
+	services.NewLinkService,
+	services.NewEvergreenService,
+	services.NewImportService,
+	services.NewCalendarService,
+	services.NewDevToService,
+	services.NewBlogService,
 )
 
 // HandlerSet provides all HTTP handlers
@@ -38,6 +73,17 @@ var HandlerSet = wire.NewSet(
 	appPkg.NewAuthHandler,
 	appPkg.NewArticleHandler,
 	appPkg.NewPostHandler,
+	appPkg.NewStoryHandler,
+	appPkg.NewCommentHandler,
+	appPkg.NewReviewHandler,
+	appPkg.NewDraftHandler,
+	appPkg.NewWebhookHandler,
+	appPkg.NewInsightsHandler,
+	appPkg.NewLinkHandler,
+	appPkg.NewImportHandler,
+	appPkg.NewCalendarHandler,
+	appPkg.NewDevToHandler,
+	appPkg.NewBlogHandler,
 )
 
 // AppSet combines all providers needed to build the application
@@ -47,6 +93,7 @@ var AppSet = wire.NewSet(
 	RepositorySet,
 	ServiceSet,
 	HandlerSet,
+	ProvideJobs,
 )
 
 // ProvideDatabase creates the MongoDB database connection
@@ -69,21 +116,79 @@ func ProvideOpenAIClient() *services.OpenAIClient {
 	return services.NewOpenAIClient()
 }
 
+// ProvideLinkedInClient creates the LinkedIn REST API client
+func ProvideLinkedInClient(client *httpclient.HTTPClient) *services.LinkedInClient {
+	return services.NewLinkedInClient(client)
+}
+
+// ProvideDevToClient creates the dev.to articles API client
+func ProvideDevToClient(client *httpclient.HTTPClient) *services.DevToClient {
+	return services.NewDevToClient(client)
+}
+
+// ProvideWordPressClient creates the WordPress REST API client, restricted to public addresses
+func ProvideWordPressClient() *services.WordPressClient {
+	return services.NewWordPressClient(httpclient.NewPublic(httpclient.DefaultConfig()))
+}
+
+// ProvideGhostClient creates the Ghost Admin API client, restricted to public addresses
+func ProvideGhostClient() *services.GhostClient {
+	return services.NewGhostClient(httpclient.NewPublic(httpclient.DefaultConfig()))
+}
+
 // ProvidePostService creates PostService with all dependencies
 func ProvidePostService(
 	openAIClient *services.OpenAIClient,
+	linkedInClient *services.LinkedInClient,
 	logRepo repositories.PostGenerationLogRepository,
 	storiesRepo repositories.SocialPostStoriesRepository,
+	webhookService services.WebhookService,
+	linkService services.LinkService,
+	linkedInConnectionService services.LinkedInConnectionService,
 ) services.PostService {
-	return services.NewPostServiceWithDeps(openAIClient, logRepo, storiesRepo)
+	return services.NewPostServiceWithDeps(openAIClient, linkedInClient, logRepo, storiesRepo, webhookService, linkService, linkedInConnectionService)
+}
+
+// ProvideJobs creates the background jobs run alongside the HTTP server
+func ProvideJobs(
+	metricsService services.MetricsService,
+	commentService services.CommentService,
+	linkedInConnectionService services.LinkedInConnectionService,
+	webhookService services.WebhookService,
+	draftService services.DraftService,
+	evergreenService services.EvergreenService,
+	articleService services.ArticleService,
+) []jobs.Job {
+	cfg := config.Get()
+	return []jobs.Job{
+		{Name: "metrics-sync", Interval: cfg.Jobs.MetricsSyncInterval, Run: metricsService.SyncDueMetrics},
+		{Name: "comments-sync", Interval: cfg.Jobs.CommentsSyncInterval, Run: commentService.SyncComments},
+		{Name: "linkedin-connections", Interval: cfg.Jobs.LinkedInCheckInterval, Run: linkedInConnectionService.CheckConnections},
+		{Name: "webhook-retries", Interval: cfg.Jobs.WebhookRetryInterval, Run: webhookService.RetryDue},
+		{Name: "scheduled-drafts", Interval: cfg.Jobs.ScheduledPublishInterval, Run: draftService.PublishDue},
+		{Name: "evergreen-reshares", Interval: cfg.Jobs.EvergreenInterval, Run: evergreenService.QueueDue},
+		{Name: "articles-ingest", Interval: cfg.Jobs.ArticlesIngestInterval, Run: articleService.Ingest},
+	}
 }
 
 // App holds all application dependencies
 type App struct {
-	FiberApp       *fiber.App
-	AuthHandler    *appPkg.AuthHandler
-	ArticleHandler *appPkg.ArticleHandler
-	PostHandler    *appPkg.PostHandler
+	FiberApp        *fiber.App
+	AuthHandler     *appPkg.AuthHandler
+	ArticleHandler  *appPkg.ArticleHandler
+	PostHandler     *appPkg.PostHandler
+	StoryHandler    *appPkg.StoryHandler
+	CommentHandler  *appPkg.CommentHandler
+	ReviewHandler   *appPkg.ReviewHandler
+	DraftHandler    *appPkg.DraftHandler
+	WebhookHandler  *appPkg.WebhookHandler
+	InsightsHandler *appPkg.InsightsHandler
+	LinkHandler     *appPkg.LinkHandler
+	ImportHandler   *appPkg.ImportHandler
+	CalendarHandler *appPkg.CalendarHandler
+	DevToHandler    *appPkg.DevToHandler
+	BlogHandler     *appPkg.BlogHandler
+	Jobs            []jobs.Job
 }
 
 // ProvideApp creates the main application struct
@@ -91,10 +196,34 @@ func ProvideApp(
 	authHandler *appPkg.AuthHandler,
 	articleHandler *appPkg.ArticleHandler,
 	postHandler *appPkg.PostHandler,
+	storyHandler *appPkg.StoryHandler,
+	commentHandler *appPkg.CommentHandler,
+	reviewHandler *appPkg.ReviewHandler,
+	draftHandler *appPkg.DraftHandler,
+	webhookHandler *appPkg.WebhookHandler,
+	insightsHandler *appPkg.InsightsHandler,
+	linkHandler *appPkg.LinkHandler,
+	importHandler *appPkg.ImportHandler,
+	calendarHandler *appPkg.CalendarHandler,
+	devToHandler *appPkg.DevToHandler,
+	blogHandler *appPkg.BlogHandler,
+	backgroundJobs []jobs.Job,
 ) *App {
 	return &App{
-		AuthHandler:    authHandler,
-		ArticleHandler: articleHandler,
-		PostHandler:    postHandler,
+		AuthHandler:     authHandler,
+		ArticleHandler:  articleHandler,
+		PostHandler:     postHandler,
+		StoryHandler:    storyHandler,
+		CommentHandler:  commentHandler,
+		ReviewHandler:   reviewHandler,
+		DraftHandler:    draftHandler,
+		WebhookHandler:  webhookHandler,
+		InsightsHandler: insightsHandler,
+		LinkHandler:     linkHandler,
+		ImportHandler:   importHandler,
+		CalendarHandler: calendarHandler,
+		DevToHandler:    devToHandler,
+		BlogHandler:     blogHandler,
+		Jobs:            backgroundJobs,
 	}
 }
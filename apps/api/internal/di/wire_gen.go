@@ -23,13 +23,59 @@ func InitializeApp() (*App, error) {
 	userRepository := repositories.NewUserRepositoryWithDB(database)
 	authService := ProvideAuthService(userRepository)
 	authHandler := app.NewAuthHandler(authService)
-	articleService := services.NewArticleService()
+	articleRepository := repositories.NewArticleRepositoryWithDB(database)
+	feedCacheRepository := repositories.NewFeedCacheRepositoryWithDB(database)
+	jobLeaseRepository := repositories.NewJobLeaseRepositoryWithDB(database)
+	articleService := services.NewArticleService(articleRepository, feedCacheRepository, userRepository, jobLeaseRepository)
 	articleHandler := app.NewArticleHandler(articleService, authService)
 	openAIClient := ProvideOpenAIClient()
+	httpClient := ProvideHTTPClient()
+	linkedInClient := ProvideLinkedInClient(httpClient)
 	postGenerationLogRepository := repositories.NewPostGenerationLogRepositoryWithDB(database)
 	socialPostStoriesRepository := repositories.NewSocialPostStoriesRepositoryWithDB(database)
-	postService := ProvidePostService(openAIClient, postGenerationLogRepository, socialPostStoriesRepository)
-	postHandler := app.NewPostHandler(postService, authService)
-	diApp := ProvideApp(authHandler, articleHandler, postHandler)
+	webhookRepository := repositories.NewWebhookRepositoryWithDB(database)
+	webhookDeliveryRepository := repositories.NewWebhookDeliveryRepositoryWithDB(database)
+	webhookService := services.NewWebhookService(webhookRepository, webhookDeliveryRepository)
+	shortLinkRepository := repositories.NewShortLinkRepositoryWithDB(database)
+	linkClickRepository := repositories.NewLinkClickRepositoryWithDB(database)
+	linkService := services.NewLinkService(shortLinkRepository, linkClickRepository)
+	linkedInConnectionService := services.NewLinkedInConnectionService(userRepository, jobLeaseRepository, linkedInClient, webhookService)
+	postService := ProvidePostService(openAIClient, linkedInClient, postGenerationLogRepository, socialPostStoriesRepository, webhookService, linkService, linkedInConnectionService)
+	previewService := services.NewPreviewService()
+	postHandler := app.NewPostHandler(postService, previewService, authService)
+	storyService := services.NewStoryService(socialPostStoriesRepository, linkedInClient)
+	postMetricsRepository := repositories.NewPostMetricsRepositoryWithDB(database)
+	metricsService := services.NewMetricsService(socialPostStoriesRepository, postMetricsRepository, userRepository, shortLinkRepository, jobLeaseRepository, linkedInClient)
+	draftRepository := repositories.NewDraftRepositoryWithDB(database)
+	insightsService := services.NewInsightsService(socialPostStoriesRepository)
+	draftService := services.NewDraftService(draftRepository, postGenerationLogRepository, userRepository, socialPostStoriesRepository, postService, insightsService)
+	notificationRepository := repositories.NewNotificationRepositoryWithDB(database)
+	notificationService := services.NewNotificationService(notificationRepository)
+	reviewService := services.NewReviewService(postGenerationLogRepository, userRepository, notificationService)
+	evergreenService := services.NewEvergreenService(socialPostStoriesRepository, userRepository, postGenerationLogRepository, draftService, reviewService, openAIClient)
+	storyHandler := app.NewStoryHandler(storyService, metricsService, evergreenService, authService)
+	postCommentRepository := repositories.NewPostCommentRepositoryWithDB(database)
+	commentService := services.NewCommentService(postCommentRepository, socialPostStoriesRepository, userRepository, jobLeaseRepository, linkedInClient, openAIClient)
+	commentHandler := app.NewCommentHandler(commentService, authService)
+	reviewHandler := app.NewReviewHandler(reviewService, notificationService, authService)
+	draftHandler := app.NewDraftHandler(draftService, authService)
+	webhookHandler := app.NewWebhookHandler(webhookService)
+	insightsHandler := app.NewInsightsHandler(insightsService)
+	linkHandler := app.NewLinkHandler(linkService)
+	postImportRepository := repositories.NewPostImportRepositoryWithDB(database)
+	importService := services.NewImportService(postImportRepository, draftRepository)
+	importHandler := app.NewImportHandler(importService, authService)
+	calendarService := services.NewCalendarService(userRepository, draftRepository, socialPostStoriesRepository)
+	calendarHandler := app.NewCalendarHandler(calendarService)
+	devToClient := ProvideDevToClient(httpClient)
+	devToService := services.NewDevToService(devToClient, postGenerationLogRepository, socialPostStoriesRepository, webhookService)
+	devToHandler := app.NewDevToHandler(devToService, authService)
+	blogConnectionRepository := repositories.NewBlogConnectionRepositoryWithDB(database)
+	wordPressClient := ProvideWordPressClient()
+	ghostClient := ProvideGhostClient()
+	blogService := services.NewBlogService(blogConnectionRepository, postGenerationLogRepository, socialPostStoriesRepository, webhookService, wordPressClient, ghostClient)
+	blogHandler := app.NewBlogHandler(blogService, authService)
+	v := ProvideJobs(metricsService, commentService, linkedInConnectionService, webhookService, draftService, evergreenService, articleService)
+	diApp := ProvideApp(authHandler, articleHandler, postHandler, storyHandler, commentHandler, reviewHandler, draftHandler, webhookHandler, insightsHandler, linkHandler, importHandler, calendarHandler, devToHandler, blogHandler, v)
 	return diApp, nil
 }
@@ -0,0 +1,129 @@
+package httpclient
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+)
+
+var (
+	// ErrNonPublicAddress is returned for URLs that point, or resolve, to a loopback, private or otherwise internal address
+	ErrNonPublicAddress = errors.New("url must point to a public address")
+	// ErrUnsupportedScheme is returned for URLs that are not http or https
+	ErrUnsupportedScheme = errors.New("url must use http or https")
+)
+
+// nonPublicPrefixes are the special-purpose ranges not covered by the netip predicates, including the
+// IPv6 ranges that embed an IPv4 address and could be used to reach an internal one
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"),  // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"),  // benchmarking
+	netip.MustParsePrefix("240.0.0.0/4"),    // reserved
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64
+	netip.MustParsePrefix("64:ff9b:1::/48"), // local-use NAT64
+	netip.MustParsePrefix("2001::/32"),      // Teredo
+	netip.MustParsePrefix("2002::/16"),      // 6to4
+	netip.MustParsePrefix("fec0::/10"),      // deprecated site-local
+}
+
+// IsPublicAddr reports whether addr is a globally routable unicast address
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidatePublicURL checks that raw is an http(s) URL whose host is not obviously internal. Host names are
+// only resolved when connecting, so clients from NewPublic must still be used to send requests to it.
+func ValidatePublicURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ErrUnsupportedScheme
+	}
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "" {
+		return fmt.Errorf("%w: missing host", ErrNonPublicAddress)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".internal") || strings.HasSuffix(host, ".local") {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddr(addr) {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, host)
+	}
+	return nil
+}
+
+// NewPublic creates an HTTPClient for URLs given by users, like webhooks and feeds. It only connects to
+// public addresses: the check runs on the IP being dialed, after name resolution, so a host name that
+// resolves to an internal address is refused as well, whenever it is resolved. Redirects are held to the
+// same rules, and no proxy is used.
+func NewPublic(config *Config) *HTTPClient {
+	if config == nil {
+		config = DefaultConfig()
+	}
+
+	dialer := &net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   publicAddressControl,
+	}
+	transport := &http.Transport{
+		DialContext:         dialer.DialContext,
+		MaxIdleConns:        config.MaxIdleConns,
+		MaxIdleConnsPerHost: config.MaxIdleConnsPerHost,
+		MaxConnsPerHost:     config.MaxConnsPerHost,
+		IdleConnTimeout:     config.IdleConnTimeout,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+
+	client := &http.Client{
+		Timeout:   config.Timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 10 {
+				return errors.New("stopped after 10 redirects")
+			}
+			return ValidatePublicURL(req.URL.String())
+		},
+	}
+
+	return &HTTPClient{
+		client: client,
+		config: config,
+	}
+}
+
+// publicAddressControl refuses connections to non-public addresses. It runs with the resolved address
+// right before connecting, which also defeats DNS rebinding.
+func publicAddressControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !IsPublicAddr(addr) {
+		return fmt.Errorf("%w: %s", ErrNonPublicAddress, addr)
+	}
+	return nil
+}
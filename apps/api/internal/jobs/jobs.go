@@ -0,0 +1,68 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"go.uber.org/zap"
+)
+
+// Job is a background task executed periodically
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Start runs each job on its own ticker until ctx is cancelled.
+// Jobs run once right away; a job with a non-positive interval is disabled.
+func Start(ctx context.Context, jobs []Job) {
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			log.Logger.Info("Background job disabled", zap.String("job", job.Name))
+			continue
+		}
+		go loop(ctx, job)
+	}
+}
+
+func loop(ctx context.Context, job Job) {
+	log.Logger.Info("Background job started", zap.String("job", job.Name), zap.Duration("interval", job.Interval))
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		runOnce(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			log.Logger.Info("Background job stopped", zap.String("job", job.Name))
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func runOnce(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Logger.Error("Background job panicked", zap.String("job", job.Name), zap.Any("panic", r))
+		}
+	}()
+
+	startTime := time.Now()
+	if err := job.Run(ctx); err != nil {
+		log.Logger.Error("Background job failed",
+			zap.String("job", job.Name),
+			zap.Duration("duration", time.Since(startTime)),
+			zap.Error(err),
+		)
+		return
+	}
+	log.Logger.Debug("Background job run completed",
+		zap.String("job", job.Name),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Article is an article read from a data source. PublishedAt is zero when the source gives no date.
+type Article struct {
+	Title       string    `bson:"title" json:"title"`
+	Url         string    `bson:"url" json:"url"`
+	Source      string    `bson:"source" json:"source"`
+	PublishedAt time.Time `bson:"publishedAt" json:"publishedAt"`
+	Summary     string    `bson:"summary,omitempty" json:"summary,omitempty"`
+	Tags        []string  `bson:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// IngestedArticle is an article of the articles collection, kept once per data source it was read from.
+// SourceKey identifies the data source configuration (type, URL and tags) shared by the users that follow it.
+// Articles that tell the same story, read from any source, share a ClusterID, which is unset until the
+// article is compared with the others.
+type IngestedArticle struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Article      `bson:",inline"`
+	SourceKey    string             `bson:"sourceKey" json:"-"`
+	SourceType   DataSourceType     `bson:"sourceType" json:"sourceType"`
+	CanonicalURL string             `bson:"canonicalUrl" json:"canonicalUrl"`
+	Language     string             `bson:"language,omitempty" json:"language,omitempty"` // "pt" or "en", the stemming of the text index
+	ClusterID    primitive.ObjectID `bson:"clusterId,omitempty" json:"clusterId,omitempty"`
+	FirstSeenAt  time.Time          `bson:"firstSeenAt" json:"firstSeenAt"`
+	FetchedAt    time.Time          `bson:"fetchedAt" json:"fetchedAt"`
+}
+
+// ArticleCluster groups the ingested articles of a story, newest first. Score is the best text search
+// relevance of its articles, when searching.
+type ArticleCluster struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	PublishedAt time.Time          `bson:"publishedAt"`
+	Score       float64            `bson:"score,omitempty"`
+	Articles    []IngestedArticle  `bson:"articles"`
+}
+
+// ArticleSort is the order of article search results
+type ArticleSort string
+
+const (
+	ArticleSortRelevance ArticleSort = "relevance"
+	ArticleSortNewest    ArticleSort = "newest"
+	ArticleSortOldest    ArticleSort = "oldest"
+)
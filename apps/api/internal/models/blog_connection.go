@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type BlogPlatform string
+
+const (
+	BlogPlatformWordPress BlogPlatform = "wordpress"
+	BlogPlatformGhost     BlogPlatform = "ghost"
+)
+
+// BlogConnection is a self-hosted blog of the user that articles can be published to.
+// WordPress sites authenticate with a username and an application password, Ghost sites with an Admin API key.
+type BlogConnection struct {
+	ID                  primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID              primitive.ObjectID `bson:"userId" json:"userId"`
+	Platform            BlogPlatform       `bson:"platform" json:"platform"`
+	SiteURL             string             `bson:"siteUrl" json:"siteUrl"`
+	SiteName            string             `bson:"siteName,omitempty" json:"siteName,omitempty"`
+	Username            string             `bson:"username,omitempty" json:"username,omitempty"`
+	ApplicationPassword string             `bson:"applicationPassword,omitempty" json:"-"`
+	AdminAPIKey         string             `bson:"adminApiKey,omitempty" json:"-"`
+	VerifiedAt          time.Time          `bson:"verifiedAt" json:"verifiedAt"`
+	CreatedAt           time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt           time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type DraftStatus string
+
+const (
+	DraftStatusDraft      DraftStatus = "draft"
+	DraftStatusScheduled  DraftStatus = "scheduled"
+	DraftStatusPublishing DraftStatus = "publishing"
+	DraftStatusPublished  DraftStatus = "published"
+)
+
+// DraftArticle is the article a draft was written from
+type DraftArticle struct {
+	Title string `bson:"title,omitempty" json:"title,omitempty"`
+	URL   string `bson:"url" json:"url"`
+}
+
+// DraftTarget is a network a scheduled draft will be published to, with an optional text override
+// and the utm_campaign of its links
+type DraftTarget struct {
+	Network  string `bson:"network" json:"network"`
+	Text     string `bson:"text,omitempty" json:"text,omitempty"`
+	Campaign string `bson:"campaign,omitempty" json:"campaign,omitempty"`
+}
+
+// Draft is an editable post text kept until it is published.
+// Version is incremented on every update and guards against concurrent edits.
+type Draft struct {
+	ID                  primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID              primitive.ObjectID `bson:"userId" json:"userId"`
+	PostGenerationLogID primitive.ObjectID `bson:"postGenerationLogId,omitempty" json:"postGenerationLogId,omitempty"`
+	EvergreenStoryID    primitive.ObjectID `bson:"evergreenStoryId,omitempty" json:"evergreenStoryId,omitempty"` // set on reshares of evergreen posts
+	ImportID            primitive.ObjectID `bson:"importId,omitempty" json:"importId,omitempty"`
+	Article             *DraftArticle      `bson:"article,omitempty" json:"article,omitempty"`
+	Title               string             `bson:"title,omitempty" json:"title,omitempty"`
+	Text                string             `bson:"text" json:"text"`
+	Parts               []PostPart         `bson:"parts,omitempty" json:"parts,omitempty"`
+	Theme               string             `bson:"theme,omitempty" json:"theme,omitempty"`
+	Status              DraftStatus        `bson:"status" json:"status"`
+	Version             int64              `bson:"version" json:"version"`
+	ScheduledAt         *time.Time         `bson:"scheduledAt,omitempty" json:"scheduledAt,omitempty"`
+	ScheduleTargets     []DraftTarget      `bson:"scheduleTargets,omitempty" json:"scheduleTargets,omitempty"`
+	ScheduleError       string             `bson:"scheduleError,omitempty" json:"scheduleError,omitempty"`
+	PublishedAt         *time.Time         `bson:"publishedAt,omitempty" json:"publishedAt,omitempty"`
+	CreatedAt           time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt           time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// FeedCacheEntry is the last result read from a data source, shared by every user that follows it.
+// ETag and LastModified are sent back to the upstream to revalidate the entry once it is stale, and
+// LastError holds the failure of the last refresh until one succeeds.
+type FeedCacheEntry struct {
+	Key          string         `bson:"_id"`
+	Type         DataSourceType `bson:"type"`
+	Url          string         `bson:"url,omitempty"`
+	Articles     []Article      `bson:"articles"`
+	Limit        int            `bson:"limit"`
+	ETag         string         `bson:"etag,omitempty"`
+	LastModified string         `bson:"lastModified,omitempty"`
+	FetchedAt    time.Time      `bson:"fetchedAt"`
+	LastError    string         `bson:"lastError,omitempty"`
+	LastErrorAt  *time.Time     `bson:"lastErrorAt,omitempty"`
+	ExpiresAt    time.Time      `bson:"expiresAt"` // removed by a TTL index when the source is no longer read
+}
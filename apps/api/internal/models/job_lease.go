@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// JobLease lets one instance at a time run a task that must not run concurrently. The lease is held
+// until LeaseUntil, so the lease of an instance that stopped expires on its own.
+type JobLease struct {
+	Name       string    `bson:"_id"`
+	Owner      string    `bson:"owner"`
+	LeaseUntil time.Time `bson:"leaseUntil"`
+}
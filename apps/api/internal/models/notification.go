@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type NotificationType string
+
+const (
+	NotificationReviewRequested  NotificationType = "review_requested"
+	NotificationChangesRequested NotificationType = "changes_requested"
+	NotificationPostApproved     NotificationType = "post_approved"
+	NotificationReviewDisabled   NotificationType = "review_disabled"
+)
+
+// Notification is an in-app message addressed to a user
+type Notification struct {
+	ID                  primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID              primitive.ObjectID `bson:"userId" json:"userId"`
+	Type                NotificationType   `bson:"type" json:"type"`
+	Message             string             `bson:"message" json:"message"`
+	PostGenerationLogID primitive.ObjectID `bson:"postGenerationLogId,omitempty" json:"postGenerationLogId,omitempty"`
+	ActorID             primitive.ObjectID `bson:"actorId,omitempty" json:"actorId,omitempty"`
+	Read                bool               `bson:"read" json:"read"`
+	CreatedAt           time.Time          `bson:"createdAt" json:"createdAt"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CommentStatus represents the inbox state of a comment
+type CommentStatus string
+
+const (
+	CommentStatusNew      CommentStatus = "new"
+	CommentStatusRead     CommentStatus = "read"
+	CommentStatusReplying CommentStatus = "replying" // a reply is being sent
+	CommentStatusReplied  CommentStatus = "replied"
+)
+
+// PostComment is a comment left on one of the user's published posts
+type PostComment struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID            primitive.ObjectID `bson:"userId" json:"userId"`
+	StoryID           primitive.ObjectID `bson:"storyId" json:"storyId"`
+	Network           string             `bson:"network" json:"network"`
+	ExternalPostID    string             `bson:"externalPostId" json:"externalPostId"`
+	ExternalCommentID string             `bson:"externalCommentId" json:"externalCommentId"`
+	AuthorURN         string             `bson:"authorUrn" json:"authorUrn"`
+	Text              string             `bson:"text" json:"text"`
+	CommentedAt       time.Time          `bson:"commentedAt" json:"commentedAt"`
+	Status            CommentStatus      `bson:"status" json:"status"`
+	ReplySuggestions  []string           `bson:"replySuggestions,omitempty" json:"replySuggestions,omitempty"`
+	ReplyText         string             `bson:"replyText,omitempty" json:"replyText,omitempty"`
+	ReplyExternalID   string             `bson:"replyExternalId,omitempty" json:"replyExternalId,omitempty"`
+	RepliedAt         *time.Time         `bson:"repliedAt,omitempty" json:"repliedAt,omitempty"`
+	ReplyClaimedAt    *time.Time         `bson:"replyClaimedAt,omitempty" json:"-"`
+	CreatedAt         time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt         time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
@@ -7,14 +7,27 @@ import (
 )
 
 type PostGenerationLog struct {
-	ID          primitive.ObjectID     `bson:"_id,omitempty" json:"id"`
-	UserID      primitive.ObjectID     `bson:"userId" json:"userId"`
-	Input       string                 `bson:"input" json:"input"`
-	Output      string                 `bson:"output" json:"output"`
-	Model       string                 `bson:"model" json:"model"`
-	Usage       map[string]interface{} `bson:"usage" json:"usage"`
-	Status      string                 `bson:"status" json:"status"` // started, success, published, error, deleted
-	Error       string                 `bson:"error,omitempty" json:"error,omitempty"`
-	CreatedAt   time.Time              `bson:"createdAt" json:"createdAt"`
-	PublishedAt *time.Time             `bson:"publishedAt,omitempty" json:"publishedAt,omitempty"`
+	ID            primitive.ObjectID     `bson:"_id,omitempty" json:"id"`
+	UserID        primitive.ObjectID     `bson:"userId" json:"userId"`
+	Input         string                 `bson:"input" json:"input"`
+	Output        string                 `bson:"output" json:"output"`
+	Parts         []PostPart             `bson:"parts,omitempty" json:"parts,omitempty"`
+	Model         string                 `bson:"model" json:"model"`
+	Usage         map[string]interface{} `bson:"usage" json:"usage"`
+	Status        PostStatus             `bson:"status" json:"status"`
+	Error         string                 `bson:"error,omitempty" json:"error,omitempty"`
+	CreatedAt     time.Time              `bson:"createdAt" json:"createdAt"`
+	PublishedAt   *time.Time             `bson:"publishedAt,omitempty" json:"publishedAt,omitempty"`
+	StatusHistory []StatusTransition     `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
+
+	ReviewStatus  ReviewStatus  `bson:"reviewStatus,omitempty" json:"reviewStatus,omitempty"`
+	ReviewHistory []ReviewEvent `bson:"reviewHistory,omitempty" json:"reviewHistory,omitempty"`
+}
+
+// CurrentReviewStatus returns the review status of the post, posts that never entered the workflow being drafts
+func (p *PostGenerationLog) CurrentReviewStatus() ReviewStatus {
+	if p.ReviewStatus == "" {
+		return ReviewStatusDraft
+	}
+	return p.ReviewStatus
 }
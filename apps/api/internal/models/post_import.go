@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ImportFormat string
+
+const (
+	ImportFormatCSV  ImportFormat = "csv"
+	ImportFormatJSON ImportFormat = "json"
+)
+
+// ImportRowResult is the outcome of one row of a bulk import. Row numbers start at 1 and do not count the CSV header.
+type ImportRowResult struct {
+	Row         int                `bson:"row" json:"row"`
+	Valid       bool               `bson:"valid" json:"valid"`
+	Errors      []string           `bson:"errors,omitempty" json:"errors,omitempty"`
+	Warnings    []string           `bson:"warnings,omitempty" json:"warnings,omitempty"`
+	Network     string             `bson:"network,omitempty" json:"network,omitempty"`
+	ScheduledAt *time.Time         `bson:"scheduledAt,omitempty" json:"scheduledAt,omitempty"`
+	DraftID     primitive.ObjectID `bson:"draftId,omitempty" json:"draftId,omitempty"`
+}
+
+// PostImport records a committed bulk import of posts and the draft created for each row
+type PostImport struct {
+	ID             primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
+	UserID         primitive.ObjectID `bson:"userId" json:"userId"`
+	Format         ImportFormat       `bson:"format" json:"format"`
+	DryRun         bool               `bson:"-" json:"dryRun"`
+	TotalRows      int                `bson:"totalRows" json:"totalRows"`
+	ValidRows      int                `bson:"validRows" json:"validRows"`
+	DraftsCreated  int                `bson:"draftsCreated" json:"draftsCreated"`
+	ScheduledPosts int                `bson:"scheduledPosts" json:"scheduledPosts"`
+	Rows           []ImportRowResult  `bson:"rows" json:"rows"`
+	CreatedAt      time.Time          `bson:"createdAt" json:"createdAt"`
+}
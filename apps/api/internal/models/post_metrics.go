@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// EngagementMetrics holds the engagement counters of a published post. Shares is nil when the network
+// does not report them, as for posts of members.
+type EngagementMetrics struct {
+	Reactions   int  `bson:"reactions" json:"reactions"`
+	Comments    int  `bson:"comments" json:"comments"`
+	Shares      *int `bson:"shares,omitempty" json:"shares,omitempty"`
+	Impressions int  `bson:"impressions,omitempty" json:"impressions,omitempty"`
+}
+
+// Total returns the sum of reactions, comments and shares
+func (m EngagementMetrics) Total() int {
+	total := m.Reactions + m.Comments
+	if m.Shares != nil {
+		total += *m.Shares
+	}
+	return total
+}
+
+// PostMetricsSnapshot is a point of the engagement time series of a published post
+type PostMetricsSnapshot struct {
+	ID                primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	StoryID           primitive.ObjectID `bson:"storyId" json:"storyId"`
+	UserID            primitive.ObjectID `bson:"userId" json:"userId"`
+	Network           string             `bson:"network" json:"network"`
+	ExternalPostID    string             `bson:"externalPostId" json:"externalPostId"`
+	EngagementMetrics `bson:",inline"`
+	CollectedAt       time.Time `bson:"collectedAt" json:"collectedAt"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import "strings"
+
+// PostFormat is how a post was published on a network
+type PostFormat string
+
+const (
+	PostFormatText     PostFormat = "text"
+	PostFormatDocument PostFormat = "document"
+)
+
+// PostPart is one part of a multi-part post: a slide of a LinkedIn document
+type PostPart struct {
+	Title string `bson:"title,omitempty" json:"title,omitempty"`
+	Text  string `bson:"text" json:"text"`
+}
+
+// JoinPostParts renders parts as a single text, for places that only show plain text
+func JoinPostParts(parts []PostPart) string {
+	blocks := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p.Title != "" {
+			blocks = append(blocks, p.Title+"\n"+p.Text)
+		} else {
+			blocks = append(blocks, p.Text)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
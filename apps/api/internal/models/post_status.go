@@ -0,0 +1,73 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PostStatus is the lifecycle status of a PostGenerationLog
+type PostStatus string
+
+const (
+	PostStatusStarted            PostStatus = "started"
+	PostStatusSuccess            PostStatus = "success" // generated, not published yet
+	PostStatusError              PostStatus = "error"
+	PostStatusPublished          PostStatus = "published"
+	PostStatusPartiallyPublished PostStatus = "partially_published"
+	PostStatusDeleted            PostStatus = "deleted"
+)
+
+// StoryStatus is the lifecycle status of a SocialPostStories entry
+type StoryStatus string
+
+const (
+	StoryStatusStarted StoryStatus = "started"
+	StoryStatusSuccess StoryStatus = "success"
+	StoryStatusError   StoryStatus = "error"
+	StoryStatusDeleted StoryStatus = "deleted"
+)
+
+// StatusTransition is one entry of the append-only status history of a post or story.
+// From is empty for the entry recorded when the document is created.
+type StatusTransition struct {
+	From    string             `bson:"from,omitempty" json:"from,omitempty"`
+	To      string             `bson:"to" json:"to"`
+	ActorID primitive.ObjectID `bson:"actorId,omitempty" json:"actorId,omitempty"`
+	Reason  string             `bson:"reason,omitempty" json:"reason,omitempty"`
+	At      time.Time          `bson:"at" json:"at"`
+}
+
+// postTransitions lists the statuses each post status may move to. Statuses missing as keys are final.
+var postTransitions = map[PostStatus][]PostStatus{
+	PostStatusStarted:            {PostStatusSuccess, PostStatusError},
+	PostStatusSuccess:            {PostStatusPublished, PostStatusPartiallyPublished},
+	PostStatusPartiallyPublished: {PostStatusPublished, PostStatusPartiallyPublished, PostStatusDeleted},
+	PostStatusPublished:          {PostStatusPublished, PostStatusDeleted},
+}
+
+// storyTransitions lists the statuses each story status may move to. Statuses missing as keys are final.
+var storyTransitions = map[StoryStatus][]StoryStatus{
+	StoryStatusStarted: {StoryStatusSuccess, StoryStatusError},
+	StoryStatusSuccess: {StoryStatusDeleted},
+}
+
+// CanTransitionTo reports whether a post may move from s to the given status
+func (s PostStatus) CanTransitionTo(to PostStatus) bool {
+	for _, allowed := range postTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// CanTransitionTo reports whether a story may move from s to the given status
+func (s StoryStatus) CanTransitionTo(to StoryStatus) bool {
+	for _, allowed := range storyTransitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
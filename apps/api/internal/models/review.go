@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ReviewStatus is the stage of a post in the editorial approval workflow
+type ReviewStatus string
+
+const (
+	ReviewStatusDraft            ReviewStatus = "draft"
+	ReviewStatusInReview         ReviewStatus = "in_review"
+	ReviewStatusChangesRequested ReviewStatus = "changes_requested"
+	ReviewStatusApproved         ReviewStatus = "approved"
+	ReviewStatusScheduled        ReviewStatus = "scheduled"
+	ReviewStatusPublished        ReviewStatus = "published"
+)
+
+// ReviewEvent records one transition of a post through the approval workflow
+type ReviewEvent struct {
+	From    ReviewStatus       `bson:"from" json:"from"`
+	To      ReviewStatus       `bson:"to" json:"to"`
+	ActorID primitive.ObjectID `bson:"actorId" json:"actorId"`
+	Comment string             `bson:"comment,omitempty" json:"comment,omitempty"`
+	At      time.Time          `bson:"at" json:"at"`
+}
+
+// ReviewSettings configures the approval workflow of a user's posts
+type ReviewSettings struct {
+	Enabled     bool                 `bson:"enabled" json:"enabled"`
+	ReviewerIDs []primitive.ObjectID `bson:"reviewerIds,omitempty" json:"reviewerIds,omitempty"`
+}
+
+// RequiresApproval reports whether posts of the user must be approved before publishing
+func (u *User) RequiresApproval() bool {
+	return u.Review != nil && u.Review.Enabled
+}
+
+// IsReviewer reports whether reviewerID may review posts of the user
+func (u *User) IsReviewer(reviewerID primitive.ObjectID) bool {
+	if u.Review == nil {
+		return false
+	}
+	for _, id := range u.Review.ReviewerIDs {
+		if id == reviewerID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// ShortLink is a tracked link of a published post. Code is the path of its /l/{code} redirect.
+type ShortLink struct {
+	ID            primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	Code          string             `bson:"code" json:"code"`
+	UserID        primitive.ObjectID `bson:"userId" json:"userId"`
+	StoryID       primitive.ObjectID `bson:"storyId,omitempty" json:"storyId,omitempty"`
+	Network       string             `bson:"network" json:"network"`
+	Campaign      string             `bson:"campaign,omitempty" json:"campaign,omitempty"`
+	TargetURL     string             `bson:"targetUrl" json:"targetUrl"`
+	ShortURL      string             `bson:"shortUrl" json:"shortUrl"`
+	Clicks        int64              `bson:"clicks" json:"clicks"`
+	LastClickedAt *time.Time         `bson:"lastClickedAt,omitempty" json:"lastClickedAt,omitempty"`
+	CreatedAt     time.Time          `bson:"createdAt" json:"createdAt"`
+}
+
+// LinkClick records one visit of a short link
+type LinkClick struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	LinkID    primitive.ObjectID `bson:"linkId" json:"linkId"`
+	StoryID   primitive.ObjectID `bson:"storyId,omitempty" json:"storyId,omitempty"`
+	Referrer  string             `bson:"referrer,omitempty" json:"referrer,omitempty"`
+	UserAgent string             `bson:"userAgent,omitempty" json:"userAgent,omitempty"`
+	ClickedAt time.Time          `bson:"clickedAt" json:"clickedAt"`
+}
@@ -6,17 +6,55 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+const (
+	NetworkLinkedIn = "linkedin"
+	NetworkDevTo    = "devto"
+)
+
 type SocialPostStories struct {
 	ID                  primitive.ObjectID     `bson:"_id,omitempty" json:"id"`
 	UserID              primitive.ObjectID     `bson:"userId" json:"userId"`
 	PostGenerationLogID primitive.ObjectID     `bson:"postGenerationLogId,omitempty" json:"postGenerationLogId,omitempty"`
+	DraftID             primitive.ObjectID     `bson:"draftId,omitempty" json:"draftId,omitempty"`
+	OriginalStoryID     primitive.ObjectID     `bson:"originalStoryId,omitempty" json:"originalStoryId,omitempty"`
 	Network             string                 `bson:"network" json:"network"` // ex: linkedin, twitter
 	PostContent         string                 `bson:"postContent" json:"postContent"`
+	Format              PostFormat             `bson:"format,omitempty" json:"format,omitempty"`
+	Parts               []PostPart             `bson:"parts,omitempty" json:"parts,omitempty"`
+	DocumentURN         string                 `bson:"documentUrn,omitempty" json:"documentUrn,omitempty"`
 	Payload             map[string]interface{} `bson:"payload" json:"payload"`
 	Response            map[string]interface{} `bson:"response" json:"response"`
-	Status              string                 `bson:"status" json:"status"` // started, success, error, deleted
+	Status              StoryStatus            `bson:"status" json:"status"`
+	StatusHistory       []StatusTransition     `bson:"statusHistory,omitempty" json:"statusHistory,omitempty"`
 	Error               string                 `bson:"error,omitempty" json:"error,omitempty"`
 	ExternalPostID      string                 `bson:"externalPostId,omitempty" json:"externalPostId,omitempty"`
+	ExternalURL         string                 `bson:"externalUrl,omitempty" json:"externalUrl,omitempty"`
+	Revisions           []PostRevision         `bson:"revisions,omitempty" json:"revisions,omitempty"`
+	EditedAt            *time.Time             `bson:"editedAt,omitempty" json:"editedAt,omitempty"`
+	LatestMetrics       *EngagementMetrics     `bson:"latestMetrics,omitempty" json:"latestMetrics,omitempty"`
+	MetricsSyncedAt     *time.Time             `bson:"metricsSyncedAt,omitempty" json:"metricsSyncedAt,omitempty"`
+	NextMetricsSyncAt   *time.Time             `bson:"nextMetricsSyncAt,omitempty" json:"-"`
+	MetricsSyncStopped  bool                   `bson:"metricsSyncStopped,omitempty" json:"-"`
+	Evergreen           *EvergreenSettings     `bson:"evergreen,omitempty" json:"evergreen,omitempty"`
 	CreatedAt           time.Time              `bson:"createdAt" json:"createdAt"`
 	UpdatedAt           time.Time              `bson:"updatedAt" json:"updatedAt"`
 }
+
+// PostRevision is a previous text of a published post, kept when the post is edited
+type PostRevision struct {
+	Text       string    `bson:"text" json:"text"`
+	LiveFrom   time.Time `bson:"liveFrom" json:"liveFrom"`
+	ReplacedAt time.Time `bson:"replacedAt" json:"replacedAt"`
+}
+
+// EvergreenSettings makes a published post be reshared periodically.
+// Reshares counts the reshares queued so far, including ones whose draft was later cancelled.
+type EvergreenSettings struct {
+	Enabled        bool       `bson:"enabled" json:"enabled"`
+	MinDaysBetween int        `bson:"minDaysBetween" json:"minDaysBetween"`
+	MaxRepetitions int        `bson:"maxRepetitions" json:"maxRepetitions"`
+	Reword         bool       `bson:"reword" json:"reword"`
+	Reshares       int        `bson:"reshares" json:"reshares"`
+	LastQueuedAt   *time.Time `bson:"lastQueuedAt,omitempty" json:"lastQueuedAt,omitempty"`
+	NextEligibleAt time.Time  `bson:"nextEligibleAt" json:"nextEligibleAt"`
+}
@@ -16,58 +16,87 @@ const (
 	AuthProviderLinkedIn AuthProvider = "linkedin"
 )
 
+// LinkedInConnectionStatus is the health of a user's LinkedIn publishing connection
+type LinkedInConnectionStatus string
+
+const (
+	LinkedInConnected      LinkedInConnectionStatus = "connected"
+	LinkedInExpiringSoon   LinkedInConnectionStatus = "expiring_soon"
+	LinkedInNeedsReconnect LinkedInConnectionStatus = "needs_reconnect"
+)
+
+// LinkedInExpiryWarningWindow is how long before expiry a token that cannot be refreshed is reported as expiring
+const LinkedInExpiryWarningWindow = 7 * 24 * time.Hour
+
 type DataSourceType string
 
 const (
 	DataSourceRSS        DataSourceType = "rss"
 	DataSourceDevTo      DataSourceType = "devto"
 	DataSourceHackerNews DataSourceType = "hackernews"
+	DataSourceReddit     DataSourceType = "reddit"
 )
 
 type DataSource struct {
 	Type DataSourceType `bson:"type" json:"type"`
 	Url  string         `bson:"url" json:"url"`
 	Tags []string       `bson:"tags,omitempty" json:"tags,omitempty"`
+	// Options read by some source types only, as listed by GET /sources/types
+	Listing       string `bson:"listing,omitempty" json:"listing,omitempty" example:"top"`
+	Window        string `bson:"window,omitempty" json:"window,omitempty" example:"week"`
+	MinScore      int    `bson:"minScore,omitempty" json:"minScore,omitempty" example:"50"`
+	SkipSelfPosts bool   `bson:"skipSelfPosts,omitempty" json:"skipSelfPosts,omitempty"`
 }
 
 // User represents a user in the system
 type User struct {
-	ID                   primitive.ObjectID `bson:"_id,omitempty" json:"id,omitempty"`
-	Email                string             `bson:"email" json:"email" example:"john@example.com"`
-	PasswordHash         string             `bson:"passwordHash,omitempty" json:"-"`
-	Name                 string             `bson:"name" json:"name" example:"John Doe"`
-	AvatarUrl            string             `bson:"avatarUrl,omitempty" json:"avatarUrl,omitempty" example:"https://example.com/avatar.jpg"`
-	Provider             AuthProvider       `bson:"provider" json:"provider" example:"local"`
-	ProviderId           string             `bson:"providerId,omitempty" json:"providerId,omitempty" example:"123456789"`
-	OpenAiApiKey         string             `bson:"openAiApiKey,omitempty" json:"openAiApiKey,omitempty"`
-	OpenAiModel          string             `bson:"openAiModel,omitempty" json:"openAiModel,omitempty"`
-	LinkedinAccessToken  string             `bson:"linkedinAccessToken,omitempty" json:"linkedinAccessToken,omitempty"`
-	LinkedinRefreshToken string             `bson:"linkedinRefreshToken,omitempty" json:"linkedinRefreshToken,omitempty"`
-	LinkedinPersonUrn    string             `bson:"linkedinPersonUrn,omitempty" json:"linkedinPersonUrn,omitempty"`
-	DataSources          []DataSource       `bson:"dataSources,omitempty" json:"dataSources,omitempty"`
-	CreatedAt            time.Time          `bson:"createdAt" json:"createdAt" example:"2024-01-01T00:00:00Z"`
-	UpdatedAt            time.Time          `bson:"updatedAt" json:"updatedAt" example:"2024-01-01T00:00:00Z"`
-	LastLogin            *time.Time         `bson:"lastLogin,omitempty" json:"lastLogin,omitempty" example:"2024-01-01T00:00:00Z"`
+	ID                            primitive.ObjectID       `bson:"_id,omitempty" json:"id,omitempty"`
+	Email                         string                   `bson:"email" json:"email" example:"john@example.com"`
+	PasswordHash                  string                   `bson:"passwordHash,omitempty" json:"-"`
+	Name                          string                   `bson:"name" json:"name" example:"John Doe"`
+	AvatarUrl                     string                   `bson:"avatarUrl,omitempty" json:"avatarUrl,omitempty" example:"https://example.com/avatar.jpg"`
+	Provider                      AuthProvider             `bson:"provider" json:"provider" example:"local"`
+	ProviderId                    string                   `bson:"providerId,omitempty" json:"providerId,omitempty" example:"123456789"`
+	OpenAiApiKey                  string                   `bson:"openAiApiKey,omitempty" json:"openAiApiKey,omitempty"`
+	OpenAiModel                   string                   `bson:"openAiModel,omitempty" json:"openAiModel,omitempty"`
+	DevToApiKey                   string                   `bson:"devToApiKey,omitempty" json:"devToApiKey,omitempty"`
+	LinkedinAccessToken           string                   `bson:"linkedinAccessToken,omitempty" json:"linkedinAccessToken,omitempty"`
+	LinkedinRefreshToken          string                   `bson:"linkedinRefreshToken,omitempty" json:"linkedinRefreshToken,omitempty"`
+	LinkedinPersonUrn             string                   `bson:"linkedinPersonUrn,omitempty" json:"linkedinPersonUrn,omitempty"`
+	LinkedinTokenExpiresAt        *time.Time               `bson:"linkedinTokenExpiresAt,omitempty" json:"linkedinTokenExpiresAt,omitempty"`
+	LinkedinRefreshTokenExpiresAt *time.Time               `bson:"linkedinRefreshTokenExpiresAt,omitempty" json:"linkedinRefreshTokenExpiresAt,omitempty"`
+	LinkedinConnectionStatus      LinkedInConnectionStatus `bson:"linkedinConnectionStatus,omitempty" json:"linkedinConnectionStatus,omitempty"`
+	LinkedinConnectionCheckedAt   *time.Time               `bson:"linkedinConnectionCheckedAt,omitempty" json:"linkedinConnectionCheckedAt,omitempty"`
+	DataSources                   []DataSource             `bson:"dataSources,omitempty" json:"dataSources,omitempty"`
+	Review                        *ReviewSettings          `bson:"review,omitempty" json:"review,omitempty"`
+	CalendarTokenHash             string                   `bson:"calendarTokenHash,omitempty" json:"-"`
+	CreatedAt                     time.Time                `bson:"createdAt" json:"createdAt" example:"2024-01-01T00:00:00Z"`
+	UpdatedAt                     time.Time                `bson:"updatedAt" json:"updatedAt" example:"2024-01-01T00:00:00Z"`
+	LastLogin                     *time.Time               `bson:"lastLogin,omitempty" json:"lastLogin,omitempty" example:"2024-01-01T00:00:00Z"`
 }
 
 // MarshalJSON implementa a interface json.Marshaler para User
 // Oculta campos sensíveis como API keys e tokens
 func (u *User) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&struct {
-		ID                 string       `json:"id"`
-		Email              string       `json:"email"`
-		Name               string       `json:"name"`
-		AvatarUrl          string       `json:"avatarUrl,omitempty"`
-		Provider           AuthProvider `json:"provider"`
-		ProviderId         string       `json:"providerId,omitempty"`
-		OpenAiApiKeyMasked string       `json:"openAiApiKey,omitempty"`
-		OpenAiModel        string       `json:"openAiModel,omitempty"`
-		HasLinkedinToken   bool         `json:"hasLinkedinToken"`
-		LinkedinPersonUrn  string       `json:"linkedinPersonUrn,omitempty"`
-		DataSources        []DataSource `json:"dataSources,omitempty"`
-		CreatedAt          string       `json:"createdAt"`
-		UpdatedAt          string       `json:"updatedAt"`
-		LastLogin          *string      `json:"lastLogin,omitempty"`
+		ID                 string                    `json:"id"`
+		Email              string                    `json:"email"`
+		Name               string                    `json:"name"`
+		AvatarUrl          string                    `json:"avatarUrl,omitempty"`
+		Provider           AuthProvider              `json:"provider"`
+		ProviderId         string                    `json:"providerId,omitempty"`
+		OpenAiApiKeyMasked string                    `json:"openAiApiKey,omitempty"`
+		OpenAiModel        string                    `json:"openAiModel,omitempty"`
+		HasLinkedinToken   bool                      `json:"hasLinkedinToken"`
+		HasDevToApiKey     bool                      `json:"hasDevToApiKey"`
+		LinkedinPersonUrn  string                    `json:"linkedinPersonUrn,omitempty"`
+		LinkedinConnection *LinkedInConnectionHealth `json:"linkedinConnection,omitempty"`
+		DataSources        []DataSource              `json:"dataSources,omitempty"`
+		Review             *ReviewSettings           `json:"review,omitempty"`
+		HasCalendarFeed    bool                      `json:"hasCalendarFeed"`
+		CreatedAt          string                    `json:"createdAt"`
+		UpdatedAt          string                    `json:"updatedAt"`
+		LastLogin          *string                   `json:"lastLogin,omitempty"`
 	}{
 		ID:                 u.ID.Hex(),
 		Email:              u.Email,
@@ -78,14 +107,70 @@ func (u *User) MarshalJSON() ([]byte, error) {
 		OpenAiApiKeyMasked: maskApiKey(u.OpenAiApiKey),
 		OpenAiModel:        u.OpenAiModel,
 		HasLinkedinToken:   u.LinkedinAccessToken != "",
+		HasDevToApiKey:     u.DevToApiKey != "",
 		LinkedinPersonUrn:  u.LinkedinPersonUrn,
+		LinkedinConnection: u.LinkedInConnectionHealth(time.Now().UTC()),
 		DataSources:        u.DataSources,
+		Review:             u.Review,
+		HasCalendarFeed:    u.CalendarTokenHash != "",
 		CreatedAt:          u.CreatedAt.Format("2006-01-01T15:04:05Z07:00"),
 		UpdatedAt:          u.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		LastLogin:          formatTimePtr(u.LastLogin),
 	})
 }
 
+// LinkedInConnectionHealth resume o estado da conexão de publicação com o LinkedIn
+type LinkedInConnectionHealth struct {
+	Status          LinkedInConnectionStatus `json:"status"`
+	ExpiresAt       *string                  `json:"expiresAt,omitempty"`
+	DaysUntilExpiry *int                     `json:"daysUntilExpiry,omitempty"`
+	CanAutoRefresh  bool                     `json:"canAutoRefresh"`
+	CheckedAt       *string                  `json:"checkedAt,omitempty"`
+}
+
+// CanRefreshLinkedInToken informa se o token pode ser renovado sem nova autorização do usuário
+func (u *User) CanRefreshLinkedInToken(now time.Time) bool {
+	if u.LinkedinRefreshToken == "" {
+		return false
+	}
+	return u.LinkedinRefreshTokenExpiresAt == nil || now.Before(*u.LinkedinRefreshTokenExpiresAt)
+}
+
+// LinkedInConnectionHealth calcula a saúde da conexão com o LinkedIn, ou nil se não estiver conectado
+func (u *User) LinkedInConnectionHealth(now time.Time) *LinkedInConnectionHealth {
+	if u.LinkedinAccessToken == "" {
+		return nil
+	}
+
+	health := &LinkedInConnectionHealth{
+		Status:         LinkedInConnected,
+		ExpiresAt:      formatTimePtr(u.LinkedinTokenExpiresAt),
+		CanAutoRefresh: u.CanRefreshLinkedInToken(now),
+		CheckedAt:      formatTimePtr(u.LinkedinConnectionCheckedAt),
+	}
+
+	if u.LinkedinTokenExpiresAt != nil {
+		remaining := u.LinkedinTokenExpiresAt.Sub(now)
+		days := int(remaining.Hours() / 24)
+		if days < 0 {
+			days = 0
+		}
+		health.DaysUntilExpiry = &days
+
+		switch {
+		case remaining <= 0 && !health.CanAutoRefresh:
+			health.Status = LinkedInNeedsReconnect
+		case remaining <= LinkedInExpiryWarningWindow && !health.CanAutoRefresh:
+			health.Status = LinkedInExpiringSoon
+		}
+	}
+
+	if u.LinkedinConnectionStatus == LinkedInNeedsReconnect {
+		health.Status = LinkedInNeedsReconnect
+	}
+	return health
+}
+
 // maskApiKey retorna uma versão mascarada da API key para exibição segura
 func maskApiKey(key string) string {
 	if key == "" {
@@ -0,0 +1,80 @@
+package models
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookPostGenerated             WebhookEvent = "post.generated"
+	WebhookPostPublished             WebhookEvent = "post.published"
+	WebhookPostFailed                WebhookEvent = "post.failed"
+	WebhookPostDeleted               WebhookEvent = "post.deleted"
+	WebhookLinkedInConnectionExpired WebhookEvent = "linkedin.connection_expired"
+	WebhookTest                      WebhookEvent = "webhook.test"
+)
+
+// WebhookEvents lists the events a webhook can subscribe to
+var WebhookEvents = []WebhookEvent{
+	WebhookPostGenerated,
+	WebhookPostPublished,
+	WebhookPostFailed,
+	WebhookPostDeleted,
+	WebhookLinkedInConnectionExpired,
+}
+
+// Webhook is an endpoint of the user that receives signed event payloads.
+// An empty Events list subscribes to every event.
+type Webhook struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty" json:"id"`
+	UserID      primitive.ObjectID `bson:"userId" json:"userId"`
+	URL         string             `bson:"url" json:"url"`
+	Description string             `bson:"description,omitempty" json:"description,omitempty"`
+	Events      []WebhookEvent     `bson:"events,omitempty" json:"events"`
+	Secret      string             `bson:"secret" json:"-"`
+	Active      bool               `bson:"active" json:"active"`
+	CreatedAt   time.Time          `bson:"createdAt" json:"createdAt"`
+	UpdatedAt   time.Time          `bson:"updatedAt" json:"updatedAt"`
+}
+
+// Subscribes reports whether the webhook should receive event
+func (w *Webhook) Subscribes(event WebhookEvent) bool {
+	if event == WebhookTest || len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookDelivery is an event sent, or still to be sent, to a webhook. The body is stored
+// as sent so that retries carry the same payload and signature input.
+type WebhookDelivery struct {
+	ID             primitive.ObjectID    `bson:"_id,omitempty" json:"id"`
+	WebhookID      primitive.ObjectID    `bson:"webhookId" json:"webhookId"`
+	UserID         primitive.ObjectID    `bson:"userId" json:"userId"`
+	Event          WebhookEvent          `bson:"event" json:"event"`
+	Body           string                `bson:"body" json:"body"`
+	Status         WebhookDeliveryStatus `bson:"status" json:"status"`
+	Attempts       int                   `bson:"attempts" json:"attempts"`
+	NextAttemptAt  *time.Time            `bson:"nextAttemptAt,omitempty" json:"nextAttemptAt,omitempty"`
+	LastStatusCode int                   `bson:"lastStatusCode,omitempty" json:"lastStatusCode,omitempty"`
+	LastError      string                `bson:"lastError,omitempty" json:"lastError,omitempty"`
+	DeliveredAt    *time.Time            `bson:"deliveredAt,omitempty" json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time             `bson:"createdAt" json:"createdAt"`
+	UpdatedAt      time.Time             `bson:"updatedAt" json:"updatedAt"`
+}
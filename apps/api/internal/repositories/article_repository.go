@@ -0,0 +1,370 @@
+package repositories
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+// ArticleFilter selects ingested articles. Empty fields do not filter.
+type ArticleFilter struct {
+	SourceKeys  []string
+	Query       string // case-insensitive match on the title or summary
+	SourceTypes []models.DataSourceType
+	Source      string // name of the source, like the title of a feed
+	Tags        []string
+	From        *time.Time
+	To          *time.Time
+}
+
+// ArticleSearch is a page of a text search over ingested articles. Text is matched against the text
+// index, stemmed as Language ("pt" or "en"); results come after the cursor in the Sort order.
+type ArticleSearch struct {
+	Filter   ArticleFilter
+	Text     string
+	Language string
+	Sort     models.ArticleSort
+	After    *ArticleCursor
+	Limit    int
+}
+
+// ArticleCursor is the position of the last story of a search page
+type ArticleCursor struct {
+	Score       float64
+	PublishedAt time.Time
+	ID          primitive.ObjectID
+}
+
+type ArticleRepository interface {
+	// UpsertMany stores the articles read from a data source, keyed by source and canonical URL. Articles without
+	// a publication date are dated when first seen, so reading them again does not move them to the top.
+	UpsertMany(ctx context.Context, articles []models.IngestedArticle) (int, error)
+	// FindClusters returns the stories with an article matching filter, newest first
+	FindClusters(ctx context.Context, filter ArticleFilter, skip, limit int) ([]models.ArticleCluster, error)
+	// Search returns the stories with an article matching a text search
+	Search(ctx context.Context, search ArticleSearch) ([]models.ArticleCluster, error)
+	// CanonicalURLs maps the given URLs to the canonical URL already stored for them by any source
+	CanonicalURLs(ctx context.Context, urls []string) (map[string]string, error)
+	ListUnclustered(ctx context.Context, limit int) ([]models.IngestedArticle, error)
+	// FindClusterByCanonicalURL returns the cluster of another article with the same canonical URL, if any
+	FindClusterByCanonicalURL(ctx context.Context, canonicalURL string, excludeID primitive.ObjectID) (primitive.ObjectID, error)
+	// ListClusteredBetween returns the titles and clusters of the articles published in the interval
+	ListClusteredBetween(ctx context.Context, from, to time.Time, limit int) ([]models.IngestedArticle, error)
+	SetClusterID(ctx context.Context, id, clusterID primitive.ObjectID) error
+}
+
+type articleRepository struct {
+	collection *mongo.Collection
+}
+
+// NewArticleRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewArticleRepositoryWithDB(database *mongo.Database) ArticleRepository {
+	return &articleRepository{
+		collection: database.Collection("articles"),
+	}
+}
+
+func (r *articleRepository) UpsertMany(ctx context.Context, articles []models.IngestedArticle) (int, error) {
+	if len(articles) == 0 {
+		return 0, nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(articles))
+	for _, a := range articles {
+		filter := bson.M{"sourceKey": a.SourceKey, "canonicalUrl": a.CanonicalURL}
+		set := bson.M{
+			"title":      a.Title,
+			"url":        a.Url,
+			"source":     a.Source,
+			"summary":    a.Summary,
+			"tags":       a.Tags,
+			"sourceType": a.SourceType,
+			"language":   a.Language,
+			"fetchedAt":  a.FetchedAt,
+		}
+		setOnInsert := bson.M{"firstSeenAt": a.FirstSeenAt}
+		if a.PublishedAt.IsZero() {
+			setOnInsert["publishedAt"] = a.FirstSeenAt
+		} else {
+			set["publishedAt"] = a.PublishedAt
+		}
+		update := bson.M{"$set": set, "$setOnInsert": setOnInsert}
+		writes = append(writes, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update).SetUpsert(true))
+	}
+
+	res, err := r.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		log.Logger.Error("Failed to upsert articles", zap.String("sourceKey", articles[0].SourceKey), zap.Error(err))
+		return 0, err
+	}
+	return int(res.UpsertedCount), nil
+}
+
+func (r *articleRepository) FindClusters(ctx context.Context, filter ArticleFilter, skip, limit int) ([]models.ArticleCluster, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: articleFilterQuery(filter)}},
+		{{Key: "$sort", Value: bson.D{{Key: "publishedAt", Value: -1}, {Key: "_id", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			// Articles not compared with the others yet are stories of their own
+			"_id":         bson.M{"$ifNull": bson.A{"$clusterId", "$_id"}},
+			"publishedAt": bson.M{"$max": "$publishedAt"},
+			"articles":    bson.M{"$push": "$$ROOT"},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "publishedAt", Value: -1}, {Key: "_id", Value: -1}}}},
+	}
+	if skip > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$skip", Value: skip}})
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: limit}})
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Logger.Error("Failed to find article clusters", zap.Int("sources", len(filter.SourceKeys)), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.ArticleCluster
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode article clusters", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *articleRepository) CanonicalURLs(ctx context.Context, urls []string) (map[string]string, error) {
+	result := make(map[string]string, len(urls))
+	if len(urls) == 0 {
+		return result, nil
+	}
+
+	opts := options.Find().SetProjection(bson.M{"url": 1, "canonicalUrl": 1})
+	cursor, err := r.collection.Find(ctx, bson.M{"url": bson.M{"$in": urls}}, opts)
+	if err != nil {
+		log.Logger.Error("Failed to find canonical URLs", zap.Int("urls", len(urls)), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var articles []models.IngestedArticle
+	if err := cursor.All(ctx, &articles); err != nil {
+		log.Logger.Error("Failed to decode canonical URLs", zap.Error(err))
+		return nil, err
+	}
+	for _, a := range articles {
+		result[a.Url] = a.CanonicalURL
+	}
+	return result, nil
+}
+
+// ListUnclustered returns the articles without a cluster, oldest first
+func (r *articleRepository) ListUnclustered(ctx context.Context, limit int) ([]models.IngestedArticle, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "firstSeenAt", Value: 1}, {Key: "_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"clusterId": bson.M{"$exists": false}}, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list unclustered articles", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.IngestedArticle
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode unclustered articles", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *articleRepository) FindClusterByCanonicalURL(ctx context.Context, canonicalURL string, excludeID primitive.ObjectID) (primitive.ObjectID, error) {
+	filter := bson.M{
+		"canonicalUrl": canonicalURL,
+		"_id":          bson.M{"$ne": excludeID},
+		"clusterId":    bson.M{"$exists": true},
+	}
+	opts := options.FindOne().SetProjection(bson.M{"clusterId": 1})
+
+	var result models.IngestedArticle
+	if err := r.collection.FindOne(ctx, filter, opts).Decode(&result); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return primitive.NilObjectID, nil
+		}
+		log.Logger.Error("Failed to find article cluster by URL", zap.String("canonicalUrl", canonicalURL), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+	return result.ClusterID, nil
+}
+
+func (r *articleRepository) ListClusteredBetween(ctx context.Context, from, to time.Time, limit int) ([]models.IngestedArticle, error) {
+	filter := bson.M{
+		"publishedAt": bson.M{"$gte": from, "$lte": to},
+		"clusterId":   bson.M{"$exists": true},
+	}
+	opts := options.Find().
+		SetProjection(bson.M{"title": 1, "clusterId": 1, "publishedAt": 1}).
+		SetSort(bson.M{"publishedAt": -1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list clustered articles", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.IngestedArticle
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode clustered articles", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *articleRepository) SetClusterID(ctx context.Context, id, clusterID primitive.ObjectID) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"clusterId": clusterID}})
+	if err != nil {
+		log.Logger.Error("Failed to set article cluster", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *articleRepository) Search(ctx context.Context, search ArticleSearch) ([]models.ArticleCluster, error) {
+	match := articleFilterQuery(search.Filter)
+	pipeline := mongo.Pipeline{}
+	if search.Text != "" {
+		text := bson.M{"$search": search.Text}
+		if search.Language != "" {
+			text["$language"] = search.Language
+		}
+		match["$text"] = text
+		pipeline = append(pipeline,
+			bson.D{{Key: "$match", Value: match}},
+			bson.D{{Key: "$addFields", Value: bson.M{"score": bson.M{"$meta": "textScore"}}}},
+		)
+	} else {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: match}})
+	}
+
+	group := bson.M{
+		"_id":         bson.M{"$ifNull": bson.A{"$clusterId", "$_id"}},
+		"publishedAt": bson.M{"$max": "$publishedAt"},
+		"articles":    bson.M{"$push": "$$ROOT"},
+	}
+	if search.Text != "" {
+		group["score"] = bson.M{"$max": "$score"}
+	}
+	pipeline = append(pipeline,
+		bson.D{{Key: "$sort", Value: bson.D{{Key: "publishedAt", Value: -1}, {Key: "_id", Value: -1}}}},
+		bson.D{{Key: "$group", Value: group}},
+	)
+
+	sort, after := articleSearchOrder(search)
+	if after != nil {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: after}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: sort}})
+	if search.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: search.Limit}})
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Logger.Error("Failed to search articles", zap.String("text", search.Text), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.ArticleCluster
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode article search results", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// articleSearchOrder returns the sort of a search and the filter of the stories after its cursor.
+// Ties are broken by story ID so every story has a single position.
+func articleSearchOrder(search ArticleSearch) (bson.D, bson.M) {
+	var sort bson.D
+	var after bson.M
+	c := search.After
+	switch search.Sort {
+	case models.ArticleSortRelevance:
+		sort = bson.D{{Key: "score", Value: -1}, {Key: "publishedAt", Value: -1}, {Key: "_id", Value: -1}}
+		if c != nil {
+			after = bson.M{"$or": bson.A{
+				bson.M{"score": bson.M{"$lt": c.Score}},
+				bson.M{"score": c.Score, "publishedAt": bson.M{"$lt": c.PublishedAt}},
+				bson.M{"score": c.Score, "publishedAt": c.PublishedAt, "_id": bson.M{"$lt": c.ID}},
+			}}
+		}
+	case models.ArticleSortOldest:
+		sort = bson.D{{Key: "publishedAt", Value: 1}, {Key: "_id", Value: 1}}
+		if c != nil {
+			after = bson.M{"$or": bson.A{
+				bson.M{"publishedAt": bson.M{"$gt": c.PublishedAt}},
+				bson.M{"publishedAt": c.PublishedAt, "_id": bson.M{"$gt": c.ID}},
+			}}
+		}
+	default:
+		sort = bson.D{{Key: "publishedAt", Value: -1}, {Key: "_id", Value: -1}}
+		if c != nil {
+			after = bson.M{"$or": bson.A{
+				bson.M{"publishedAt": bson.M{"$lt": c.PublishedAt}},
+				bson.M{"publishedAt": c.PublishedAt, "_id": bson.M{"$lt": c.ID}},
+			}}
+		}
+	}
+	return sort, after
+}
+
+// articleFilterQuery builds the Mongo filter of an ArticleFilter
+func articleFilterQuery(filter ArticleFilter) bson.M {
+	query := bson.M{"sourceKey": bson.M{"$in": filter.SourceKeys}}
+	if filter.Query != "" {
+		pattern := containsIgnoreCasePattern(filter.Query)
+		query["$or"] = bson.A{bson.M{"title": pattern}, bson.M{"summary": pattern}}
+	}
+	if len(filter.SourceTypes) > 0 {
+		query["sourceType"] = bson.M{"$in": filter.SourceTypes}
+	}
+	if filter.Source != "" {
+		query["source"] = filter.Source
+	}
+	if len(filter.Tags) > 0 {
+		query["tags"] = bson.M{"$in": filter.Tags}
+	}
+	published := bson.M{}
+	if filter.From != nil {
+		published["$gte"] = *filter.From
+	}
+	if filter.To != nil {
+		published["$lte"] = *filter.To
+	}
+	if len(published) > 0 {
+		query["publishedAt"] = published
+	}
+	return query
+}
+
+// containsIgnoreCasePattern matches values that contain text, ignoring case
+func containsIgnoreCasePattern(text string) bson.M {
+	return bson.M{"$regex": regexp.QuoteMeta(text), "$options": "i"}
+}
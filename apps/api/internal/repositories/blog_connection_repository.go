@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type BlogConnectionRepository interface {
+	Create(ctx context.Context, connection *models.BlogConnection) (primitive.ObjectID, error)
+	GetByID(ctx context.Context, id primitive.ObjectID) (*models.BlogConnection, error)
+	ListByUser(ctx context.Context, userID primitive.ObjectID) ([]models.BlogConnection, error)
+	Update(ctx context.Context, id primitive.ObjectID, set bson.M) (*models.BlogConnection, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+}
+
+type blogConnectionRepository struct {
+	collection *mongo.Collection
+}
+
+// NewBlogConnectionRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewBlogConnectionRepositoryWithDB(database *mongo.Database) BlogConnectionRepository {
+	return &blogConnectionRepository{
+		collection: database.Collection("blog_connections"),
+	}
+}
+
+func (r *blogConnectionRepository) Create(ctx context.Context, connection *models.BlogConnection) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, connection)
+	if err != nil {
+		log.Logger.Error("Failed to create blog connection", zap.String("userId", connection.UserID.Hex()), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, ErrInvalidInsertedID
+	}
+	return id, nil
+}
+
+func (r *blogConnectionRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.BlogConnection, error) {
+	var result models.BlogConnection
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get blog connection", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *blogConnectionRepository) ListByUser(ctx context.Context, userID primitive.ObjectID) ([]models.BlogConnection, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"userId": userID}, options.Find().SetSort(bson.M{"createdAt": 1}))
+	if err != nil {
+		log.Logger.Error("Failed to list blog connections", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.BlogConnection
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode blog connections", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// Update applies set to the connection and returns the updated document, or nil if it does not exist
+func (r *blogConnectionRepository) Update(ctx context.Context, id primitive.ObjectID, set bson.M) (*models.BlogConnection, error) {
+	fields := bson.M{"updatedAt": time.Now().UTC()}
+	for k, v := range set {
+		fields[k] = v
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var result models.BlogConnection
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$set": fields}, opts).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to update blog connection", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *blogConnectionRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		log.Logger.Error("Failed to delete blog connection", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type DraftRepository interface {
+	Create(ctx context.Context, draft *models.Draft) (primitive.ObjectID, error)
+	CreateMany(ctx context.Context, drafts []models.Draft) ([]primitive.ObjectID, error)
+	GetByID(ctx context.Context, id primitive.ObjectID) (*models.Draft, error)
+	ListByUser(ctx context.Context, userID primitive.ObjectID, status models.DraftStatus, limit int) ([]models.Draft, error)
+	UpdateVersioned(ctx context.Context, id primitive.ObjectID, version int64, set bson.M) (*models.Draft, error)
+	MarkPublished(ctx context.Context, id primitive.ObjectID, publishedAt time.Time) error
+	ClaimDueScheduled(ctx context.Context, now time.Time, lease time.Duration) (*models.Draft, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+	DeleteByImport(ctx context.Context, importID primitive.ObjectID) error
+}
+
+type draftRepository struct {
+	collection *mongo.Collection
+}
+
+// NewDraftRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewDraftRepositoryWithDB(database *mongo.Database) DraftRepository {
+	return &draftRepository{
+		collection: database.Collection("drafts"),
+	}
+}
+
+func (r *draftRepository) Create(ctx context.Context, draft *models.Draft) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, draft)
+	if err != nil {
+		log.Logger.Error("Failed to create draft", zap.String("userId", draft.UserID.Hex()), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, ErrInvalidInsertedID
+	}
+	return id, nil
+}
+
+// CreateMany stores drafts in a single batch and returns their IDs in the same order
+func (r *draftRepository) CreateMany(ctx context.Context, drafts []models.Draft) ([]primitive.ObjectID, error) {
+	docs := make([]interface{}, len(drafts))
+	for i := range drafts {
+		docs[i] = drafts[i]
+	}
+	res, err := r.collection.InsertMany(ctx, docs)
+	if err != nil {
+		log.Logger.Error("Failed to create drafts", zap.Int("drafts", len(drafts)), zap.Error(err))
+		return nil, err
+	}
+
+	ids := make([]primitive.ObjectID, len(res.InsertedIDs))
+	for i, insertedID := range res.InsertedIDs {
+		id, ok := insertedID.(primitive.ObjectID)
+		if !ok {
+			return nil, ErrInvalidInsertedID
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func (r *draftRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Draft, error) {
+	var result models.Draft
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get draft", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *draftRepository) ListByUser(ctx context.Context, userID primitive.ObjectID, status models.DraftStatus, limit int) ([]models.Draft, error) {
+	filter := bson.M{"userId": userID}
+	if status != "" {
+		filter["status"] = status
+	}
+	opts := options.Find().SetSort(bson.M{"updatedAt": -1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list drafts", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.Draft
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode drafts", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// UpdateVersioned applies set to the draft only if it is still at the given version, incrementing it.
+// Fields set to nil are removed. It returns the updated draft, or nil when the draft does not exist
+// or was changed in the meantime.
+func (r *draftRepository) UpdateVersioned(ctx context.Context, id primitive.ObjectID, version int64, set bson.M) (*models.Draft, error) {
+	fields := bson.M{"updatedAt": time.Now().UTC()}
+	unset := bson.M{}
+	for k, v := range set {
+		if v == nil {
+			unset[k] = ""
+			continue
+		}
+		fields[k] = v
+	}
+	update := bson.M{
+		"$set": fields,
+		"$inc": bson.M{"version": 1},
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var result models.Draft
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id, "version": version}, update, opts).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to update draft", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+// MarkPublished marks the draft as published whatever its version, for when it was published after its
+// claim was lost
+func (r *draftRepository) MarkPublished(ctx context.Context, id primitive.ObjectID, publishedAt time.Time) error {
+	update := bson.M{
+		"$set":   bson.M{"status": models.DraftStatusPublished, "publishedAt": publishedAt, "updatedAt": time.Now().UTC()},
+		"$unset": bson.M{"scheduledAt": "", "scheduleError": ""},
+		"$inc":   bson.M{"version": 1},
+	}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update); err != nil {
+		log.Logger.Error("Failed to mark draft as published", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ClaimDueScheduled moves the oldest scheduled draft whose time has come to publishing and returns it,
+// or nil when none is due. Drafts left publishing for longer than lease, because the instance that
+// claimed them stopped, are claimed again: callers must check which of their targets were published.
+func (r *draftRepository) ClaimDueScheduled(ctx context.Context, now time.Time, lease time.Duration) (*models.Draft, error) {
+	filter := bson.M{
+		"$or": bson.A{
+			bson.M{"status": models.DraftStatusScheduled, "scheduledAt": bson.M{"$lte": now}},
+			bson.M{"status": models.DraftStatusPublishing, "updatedAt": bson.M{"$lte": now.Add(-lease)}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{"status": models.DraftStatusPublishing, "updatedAt": now},
+		"$inc": bson.M{"version": 1},
+	}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"scheduledAt": 1}).
+		SetReturnDocument(options.After)
+
+	var result models.Draft
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to claim scheduled draft", zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *draftRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		log.Logger.Error("Failed to delete draft", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// DeleteByImport removes every draft created by an import
+func (r *draftRepository) DeleteByImport(ctx context.Context, importID primitive.ObjectID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"importId": importID})
+	if err != nil {
+		log.Logger.Error("Failed to delete drafts of import", zap.String("importId", importID.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
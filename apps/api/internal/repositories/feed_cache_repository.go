@@ -0,0 +1,103 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type FeedCacheRepository interface {
+	Get(ctx context.Context, key string) (*models.FeedCacheEntry, error)
+	GetMany(ctx context.Context, keys []string) ([]models.FeedCacheEntry, error)
+	Save(ctx context.Context, entry *models.FeedCacheEntry) error
+	// Touch marks an entry as fresh again after the upstream answered that it did not change
+	Touch(ctx context.Context, key string, fetchedAt, expiresAt time.Time) error
+	// SetError records a failed refresh, creating the entry when the source was never read
+	SetError(ctx context.Context, key string, sourceType models.DataSourceType, url, message string, at, expiresAt time.Time) error
+}
+
+type feedCacheRepository struct {
+	collection *mongo.Collection
+}
+
+// NewFeedCacheRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewFeedCacheRepositoryWithDB(database *mongo.Database) FeedCacheRepository {
+	return &feedCacheRepository{
+		collection: database.Collection("feed_cache"),
+	}
+}
+
+func (r *feedCacheRepository) Get(ctx context.Context, key string) (*models.FeedCacheEntry, error) {
+	var result models.FeedCacheEntry
+	err := r.collection.FindOne(ctx, bson.M{"_id": key}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get feed cache entry", zap.String("key", key), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetMany returns the entries with the given keys, without their articles
+func (r *feedCacheRepository) GetMany(ctx context.Context, keys []string) ([]models.FeedCacheEntry, error) {
+	opts := options.Find().SetProjection(bson.M{"articles": 0})
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": keys}}, opts)
+	if err != nil {
+		log.Logger.Error("Failed to get feed cache entries", zap.Int("keys", len(keys)), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.FeedCacheEntry
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode feed cache entries", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *feedCacheRepository) Save(ctx context.Context, entry *models.FeedCacheEntry) error {
+	_, err := r.collection.ReplaceOne(ctx, bson.M{"_id": entry.Key}, entry, options.Replace().SetUpsert(true))
+	if err != nil {
+		log.Logger.Error("Failed to save feed cache entry", zap.String("key", entry.Key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *feedCacheRepository) Touch(ctx context.Context, key string, fetchedAt, expiresAt time.Time) error {
+	update := bson.M{
+		"$set":   bson.M{"fetchedAt": fetchedAt, "expiresAt": expiresAt},
+		"$unset": bson.M{"lastError": "", "lastErrorAt": ""},
+	}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": key}, update); err != nil {
+		log.Logger.Error("Failed to touch feed cache entry", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *feedCacheRepository) SetError(ctx context.Context, key string, sourceType models.DataSourceType, url, message string, at, expiresAt time.Time) error {
+	update := bson.M{"$set": bson.M{
+		"type":        sourceType,
+		"url":         url,
+		"lastError":   message,
+		"lastErrorAt": at,
+		"expiresAt":   expiresAt,
+	}}
+	opts := options.Update().SetUpsert(true)
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": key}, update, opts); err != nil {
+		log.Logger.Error("Failed to record feed cache error", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
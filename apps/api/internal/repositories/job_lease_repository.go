@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"go.uber.org/zap"
+)
+
+type JobLeaseRepository interface {
+	// Acquire takes the lease of a task for owner, or extends it when owner already holds it. It returns
+	// false while another owner holds an unexpired lease.
+	Acquire(ctx context.Context, name, owner string, now time.Time, lease time.Duration) (bool, error)
+	// Release gives up a lease held by owner
+	Release(ctx context.Context, name, owner string) error
+}
+
+type jobLeaseRepository struct {
+	collection *mongo.Collection
+}
+
+// NewJobLeaseRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewJobLeaseRepositoryWithDB(database *mongo.Database) JobLeaseRepository {
+	return &jobLeaseRepository{
+		collection: database.Collection("job_leases"),
+	}
+}
+
+func (r *jobLeaseRepository) Acquire(ctx context.Context, name, owner string, now time.Time, lease time.Duration) (bool, error) {
+	filter := bson.M{
+		"_id": name,
+		"$or": bson.A{
+			bson.M{"owner": owner},
+			bson.M{"leaseUntil": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"owner": owner, "leaseUntil": now.Add(lease)}}
+	// When the lease is held by someone else the filter matches nothing and the upsert collides with its _id
+	_, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		log.Logger.Error("Failed to acquire job lease", zap.String("name", name), zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *jobLeaseRepository) Release(ctx context.Context, name, owner string) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": name, "owner": owner})
+	if err != nil {
+		log.Logger.Error("Failed to release job lease", zap.String("name", name), zap.Error(err))
+		return err
+	}
+	return nil
+}
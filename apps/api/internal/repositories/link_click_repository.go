@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type LinkClickRepository interface {
+	Create(ctx context.Context, click *models.LinkClick) (primitive.ObjectID, error)
+}
+
+type linkClickRepository struct {
+	collection *mongo.Collection
+}
+
+// NewLinkClickRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewLinkClickRepositoryWithDB(database *mongo.Database) LinkClickRepository {
+	return &linkClickRepository{
+		collection: database.Collection("link_clicks"),
+	}
+}
+
+func (r *linkClickRepository) Create(ctx context.Context, click *models.LinkClick) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, click)
+	if err != nil {
+		log.Logger.Error("Failed to record link click", zap.String("linkId", click.LinkID.Hex()), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, ErrInvalidInsertedID
+	}
+	return id, nil
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type NotificationRepository interface {
+	Create(ctx context.Context, notification *models.Notification) (primitive.ObjectID, error)
+	ListByUser(ctx context.Context, userID primitive.ObjectID, unreadOnly bool, limit int) ([]models.Notification, error)
+	MarkRead(ctx context.Context, userID, id primitive.ObjectID) (bool, error)
+}
+
+type notificationRepository struct {
+	collection *mongo.Collection
+}
+
+// NewNotificationRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewNotificationRepositoryWithDB(database *mongo.Database) NotificationRepository {
+	return &notificationRepository{
+		collection: database.Collection("notifications"),
+	}
+}
+
+func (r *notificationRepository) Create(ctx context.Context, notification *models.Notification) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, notification)
+	if err != nil {
+		log.Logger.Error("Failed to create notification", zap.String("userId", notification.UserID.Hex()), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+	id, _ := res.InsertedID.(primitive.ObjectID)
+	return id, nil
+}
+
+func (r *notificationRepository) ListByUser(ctx context.Context, userID primitive.ObjectID, unreadOnly bool, limit int) ([]models.Notification, error) {
+	filter := bson.M{"userId": userID}
+	if unreadOnly {
+		filter["read"] = false
+	}
+	opts := options.Find().SetSort(bson.M{"createdAt": -1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list notifications", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.Notification
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode notifications", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// MarkRead marks a notification of the user as read and reports whether it exists
+func (r *notificationRepository) MarkRead(ctx context.Context, userID, id primitive.ObjectID) (bool, error) {
+	res, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": id, "userId": userID},
+		bson.M{"$set": bson.M{"read": true}},
+	)
+	if err != nil {
+		log.Logger.Error("Failed to mark notification as read", zap.String("id", id.Hex()), zap.Error(err))
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
@@ -0,0 +1,201 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+// CommentCursor is the position of the last comment of an inbox page
+type CommentCursor struct {
+	CommentedAt time.Time
+	ID          primitive.ObjectID
+}
+
+type PostCommentRepository interface {
+	Upsert(ctx context.Context, comment *models.PostComment) (bool, error)
+	GetByID(ctx context.Context, id primitive.ObjectID) (*models.PostComment, error)
+	// ListByUser returns the comments of a user newest first, starting after the cursor when one is given
+	ListByUser(ctx context.Context, userID primitive.ObjectID, status models.CommentStatus, after *CommentCursor, limit int) ([]models.PostComment, error)
+	// UpdateStatus moves a comment between new and read; it returns false when the comment is being, or has been, replied to
+	UpdateStatus(ctx context.Context, id primitive.ObjectID, status models.CommentStatus) (bool, error)
+	SetReplySuggestions(ctx context.Context, id primitive.ObjectID, suggestions []string) error
+	// ClaimReply moves a new or read comment to replying and returns it as it was before, or nil when another
+	// reply holds it. Claims older than lease, left by a request that stopped, are taken over.
+	ClaimReply(ctx context.Context, id primitive.ObjectID, now time.Time, lease time.Duration) (*models.PostComment, error)
+	// ReleaseReply gives a claimed comment its previous status back after its reply failed
+	ReleaseReply(ctx context.Context, id primitive.ObjectID, status models.CommentStatus) error
+	MarkReplied(ctx context.Context, id primitive.ObjectID, replyText, replyExternalID string, repliedAt time.Time) error
+}
+
+type postCommentRepository struct {
+	collection *mongo.Collection
+}
+
+// NewPostCommentRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewPostCommentRepositoryWithDB(database *mongo.Database) PostCommentRepository {
+	return &postCommentRepository{
+		collection: database.Collection("post_comments"),
+	}
+}
+
+// Upsert stores a comment pulled from a network, keyed by its external ID.
+// Inbox state of existing comments is preserved; it reports whether the comment is new.
+func (r *postCommentRepository) Upsert(ctx context.Context, comment *models.PostComment) (bool, error) {
+	now := time.Now().UTC()
+	filter := bson.M{"externalCommentId": comment.ExternalCommentID}
+	update := bson.M{
+		"$set": bson.M{
+			"text":      comment.Text,
+			"updatedAt": now,
+		},
+		"$setOnInsert": bson.M{
+			"userId":         comment.UserID,
+			"storyId":        comment.StoryID,
+			"network":        comment.Network,
+			"externalPostId": comment.ExternalPostID,
+			"authorUrn":      comment.AuthorURN,
+			"commentedAt":    comment.CommentedAt,
+			"status":         models.CommentStatusNew,
+			"createdAt":      now,
+		},
+	}
+
+	res, err := r.collection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err != nil {
+		log.Logger.Error("Failed to upsert post comment", zap.String("externalCommentId", comment.ExternalCommentID), zap.Error(err))
+		return false, err
+	}
+	return res.UpsertedCount > 0, nil
+}
+
+func (r *postCommentRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.PostComment, error) {
+	var result models.PostComment
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get post comment", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *postCommentRepository) ListByUser(ctx context.Context, userID primitive.ObjectID, status models.CommentStatus, after *CommentCursor, limit int) ([]models.PostComment, error) {
+	filter := bson.M{"userId": userID}
+	if status != "" {
+		filter["status"] = status
+	}
+	if after != nil {
+		filter["$or"] = bson.A{
+			bson.M{"commentedAt": bson.M{"$lt": after.CommentedAt}},
+			bson.M{"commentedAt": after.CommentedAt, "_id": bson.M{"$lt": after.ID}},
+		}
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "commentedAt", Value: -1}, {Key: "_id", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list post comments", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.PostComment
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode post comments", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *postCommentRepository) UpdateStatus(ctx context.Context, id primitive.ObjectID, status models.CommentStatus) (bool, error) {
+	filter := bson.M{"_id": id, "status": bson.M{"$in": bson.A{models.CommentStatusNew, models.CommentStatusRead}}}
+	update := bson.M{"$set": bson.M{"status": status, "updatedAt": time.Now().UTC()}}
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Logger.Error("Failed to update post comment status", zap.String("id", id.Hex()), zap.Error(err))
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (r *postCommentRepository) SetReplySuggestions(ctx context.Context, id primitive.ObjectID, suggestions []string) error {
+	return r.set(ctx, id, bson.M{"replySuggestions": suggestions})
+}
+
+func (r *postCommentRepository) ClaimReply(ctx context.Context, id primitive.ObjectID, now time.Time, lease time.Duration) (*models.PostComment, error) {
+	filter := bson.M{
+		"_id": id,
+		"$or": bson.A{
+			bson.M{"status": bson.M{"$in": bson.A{models.CommentStatusNew, models.CommentStatusRead}}},
+			bson.M{"status": models.CommentStatusReplying, "replyClaimedAt": bson.M{"$lte": now.Add(-lease)}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"status": models.CommentStatusReplying, "replyClaimedAt": now, "updatedAt": now}}
+
+	var previous models.PostComment
+	err := r.collection.FindOneAndUpdate(ctx, filter, update).Decode(&previous)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to claim post comment for reply", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &previous, nil
+}
+
+func (r *postCommentRepository) ReleaseReply(ctx context.Context, id primitive.ObjectID, status models.CommentStatus) error {
+	update := bson.M{
+		"$set":   bson.M{"status": status, "updatedAt": time.Now().UTC()},
+		"$unset": bson.M{"replyClaimedAt": ""},
+	}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "status": models.CommentStatusReplying}, update)
+	if err != nil {
+		log.Logger.Error("Failed to release post comment reply claim", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *postCommentRepository) MarkReplied(ctx context.Context, id primitive.ObjectID, replyText, replyExternalID string, repliedAt time.Time) error {
+	update := bson.M{
+		"$set": bson.M{
+			"status":          models.CommentStatusReplied,
+			"replyText":       replyText,
+			"replyExternalId": replyExternalID,
+			"repliedAt":       repliedAt,
+			"updatedAt":       time.Now().UTC(),
+		},
+		"$unset": bson.M{"replyClaimedAt": ""},
+	}
+	_, err := r.collection.UpdateByID(ctx, id, update)
+	if err != nil {
+		log.Logger.Error("Failed to mark post comment as replied", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *postCommentRepository) set(ctx context.Context, id primitive.ObjectID, fields bson.M) error {
+	fields["updatedAt"] = time.Now().UTC()
+	_, err := r.collection.UpdateByID(ctx, id, bson.M{"$set": fields})
+	if err != nil {
+		log.Logger.Error("Failed to update post comment", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
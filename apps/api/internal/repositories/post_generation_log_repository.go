@@ -16,8 +16,12 @@ import (
 
 type PostGenerationLogRepository interface {
 	Create(ctx context.Context, log *models.PostGenerationLog) (primitive.ObjectID, error)
+	GetByID(ctx context.Context, id primitive.ObjectID) (*models.PostGenerationLog, error)
 	UpdateByID(ctx context.Context, id primitive.ObjectID, update bson.M) error
+	TransitionStatus(ctx context.Context, id primitive.ObjectID, transition models.StatusTransition, set bson.M) (bool, error)
 	ListByUser(ctx context.Context, userId primitive.ObjectID, limit int) ([]models.PostGenerationLog, error)
+	TransitionReview(ctx context.Context, id primitive.ObjectID, from models.ReviewStatus, event models.ReviewEvent) (bool, error)
+	ListByReviewStatus(ctx context.Context, userIDs []primitive.ObjectID, status models.ReviewStatus, limit int) ([]models.PostGenerationLog, error)
 }
 
 type postGenerationLogRepository struct {
@@ -42,6 +46,13 @@ func NewPostGenerationLogRepositoryWithDB(database *mongo.Database) PostGenerati
 }
 
 func (r *postGenerationLogRepository) Create(ctx context.Context, logEntry *models.PostGenerationLog) (primitive.ObjectID, error) {
+	if len(logEntry.StatusHistory) == 0 {
+		logEntry.StatusHistory = []models.StatusTransition{{
+			To:      string(logEntry.Status),
+			ActorID: logEntry.UserID,
+			At:      logEntry.CreatedAt,
+		}}
+	}
 	res, err := r.collection.InsertOne(ctx, logEntry)
 	if err != nil {
 		log.Logger.Error("Failed to create post generation log", zap.Error(err))
@@ -52,6 +63,19 @@ func (r *postGenerationLogRepository) Create(ctx context.Context, logEntry *mode
 	return id, nil
 }
 
+func (r *postGenerationLogRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.PostGenerationLog, error) {
+	var result models.PostGenerationLog
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get post generation log", zap.String("logId", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
 func (r *postGenerationLogRepository) UpdateByID(ctx context.Context, id primitive.ObjectID, update bson.M) error {
 	_, err := r.collection.UpdateByID(ctx, id, update)
 	if err != nil {
@@ -62,6 +86,35 @@ func (r *postGenerationLogRepository) UpdateByID(ctx context.Context, id primiti
 	return nil
 }
 
+// TransitionStatus moves a post from transition.From to transition.To, applying the extra set fields
+// and appending the transition to its history. It reports false when the post is no longer in
+// transition.From, so concurrent transitions cannot overwrite each other.
+func (r *postGenerationLogRepository) TransitionStatus(ctx context.Context, id primitive.ObjectID, transition models.StatusTransition, set bson.M) (bool, error) {
+	fields := bson.M{"status": transition.To}
+	for k, v := range set {
+		fields[k] = v
+	}
+	update := bson.M{
+		"$set":  fields,
+		"$push": bson.M{"statusHistory": transition},
+	}
+
+	res, err := r.collection.UpdateOne(ctx, bson.M{"_id": id, "status": transition.From}, update)
+	if err != nil {
+		log.Logger.Error("Failed to transition post generation log status", zap.String("logId", id.Hex()), zap.Error(err))
+		return false, err
+	}
+	if res.MatchedCount == 0 {
+		return false, nil
+	}
+	log.Logger.Info("Post generation log status changed",
+		zap.String("logId", id.Hex()),
+		zap.String("from", transition.From),
+		zap.String("to", transition.To),
+	)
+	return true, nil
+}
+
 func (r *postGenerationLogRepository) ListByUser(ctx context.Context, userId primitive.ObjectID, limit int) ([]models.PostGenerationLog, error) {
 	filter := bson.M{"userId": userId}
 	opts := options.Find().SetSort(bson.M{"createdAt": -1})
@@ -82,3 +135,44 @@ func (r *postGenerationLogRepository) ListByUser(ctx context.Context, userId pri
 	}
 	return results, nil
 }
+
+// TransitionReview moves a post to event.To only if it is still in the from status, recording the event.
+// It reports false when the post changed status concurrently.
+func (r *postGenerationLogRepository) TransitionReview(ctx context.Context, id primitive.ObjectID, from models.ReviewStatus, event models.ReviewEvent) (bool, error) {
+	filter := bson.M{"_id": id, "reviewStatus": from}
+	if from == models.ReviewStatusDraft {
+		// Posts that never entered the workflow have no reviewStatus yet
+		filter["reviewStatus"] = bson.M{"$in": bson.A{nil, "", models.ReviewStatusDraft}}
+	}
+	update := bson.M{
+		"$set":  bson.M{"reviewStatus": event.To},
+		"$push": bson.M{"reviewHistory": event},
+	}
+
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Logger.Error("Failed to transition post review", zap.String("logId", id.Hex()), zap.Error(err))
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+func (r *postGenerationLogRepository) ListByReviewStatus(ctx context.Context, userIDs []primitive.ObjectID, status models.ReviewStatus, limit int) ([]models.PostGenerationLog, error) {
+	filter := bson.M{"userId": bson.M{"$in": userIDs}, "reviewStatus": status}
+	opts := options.Find().SetSort(bson.M{"createdAt": 1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list posts by review status", zap.String("status", string(status)), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.PostGenerationLog
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
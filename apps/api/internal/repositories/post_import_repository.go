@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type PostImportRepository interface {
+	Create(ctx context.Context, postImport *models.PostImport) (primitive.ObjectID, error)
+	GetByID(ctx context.Context, id primitive.ObjectID) (*models.PostImport, error)
+	ListByUser(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.PostImport, error)
+}
+
+type postImportRepository struct {
+	collection *mongo.Collection
+}
+
+// NewPostImportRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewPostImportRepositoryWithDB(database *mongo.Database) PostImportRepository {
+	return &postImportRepository{
+		collection: database.Collection("post_imports"),
+	}
+}
+
+func (r *postImportRepository) Create(ctx context.Context, postImport *models.PostImport) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, postImport)
+	if err != nil {
+		log.Logger.Error("Failed to create post import", zap.String("userId", postImport.UserID.Hex()), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, ErrInvalidInsertedID
+	}
+	return id, nil
+}
+
+func (r *postImportRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.PostImport, error) {
+	var result models.PostImport
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get post import", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+// ListByUser returns the imports of a user, newest first, without their per-row results
+func (r *postImportRepository) ListByUser(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.PostImport, error) {
+	opts := options.Find().
+		SetSort(bson.M{"createdAt": -1}).
+		SetProjection(bson.M{"rows": 0})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"userId": userID}, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list post imports", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.PostImport
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode post imports", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
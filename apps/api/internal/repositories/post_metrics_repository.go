@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type PostMetricsRepository interface {
+	Create(ctx context.Context, snapshot *models.PostMetricsSnapshot) (primitive.ObjectID, error)
+	ListByStory(ctx context.Context, storyID primitive.ObjectID, limit int) ([]models.PostMetricsSnapshot, error)
+}
+
+type postMetricsRepository struct {
+	collection *mongo.Collection
+}
+
+// NewPostMetricsRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewPostMetricsRepositoryWithDB(database *mongo.Database) PostMetricsRepository {
+	return &postMetricsRepository{
+		collection: database.Collection("post_metrics"),
+	}
+}
+
+func (r *postMetricsRepository) Create(ctx context.Context, snapshot *models.PostMetricsSnapshot) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, snapshot)
+	if err != nil {
+		log.Logger.Error("Failed to create post metrics snapshot", zap.String("storyId", snapshot.StoryID.Hex()), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, ErrInvalidInsertedID
+	}
+	return id, nil
+}
+
+// ListByStory returns the metrics time series of a story, oldest first
+func (r *postMetricsRepository) ListByStory(ctx context.Context, storyID primitive.ObjectID, limit int) ([]models.PostMetricsSnapshot, error) {
+	filter := bson.M{"storyId": storyID}
+	opts := options.Find().SetSort(bson.M{"collectedAt": 1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list post metrics", zap.String("storyId", storyID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.PostMetricsSnapshot
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode post metrics", zap.String("storyId", storyID.Hex()), zap.Error(err))
+		return nil, err
+	}
+
+	return results, nil
+}
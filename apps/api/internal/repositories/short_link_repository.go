@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type ShortLinkRepository interface {
+	Create(ctx context.Context, link *models.ShortLink) (primitive.ObjectID, error)
+	GetByCode(ctx context.Context, code string) (*models.ShortLink, error)
+	ListByStory(ctx context.Context, storyID primitive.ObjectID) ([]models.ShortLink, error)
+	AttachStory(ctx context.Context, ids []primitive.ObjectID, storyID primitive.ObjectID) error
+	IncrementClicks(ctx context.Context, id primitive.ObjectID, at time.Time) error
+	CountClicksByStories(ctx context.Context, storyIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error)
+}
+
+type shortLinkRepository struct {
+	collection *mongo.Collection
+}
+
+// NewShortLinkRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewShortLinkRepositoryWithDB(database *mongo.Database) ShortLinkRepository {
+	return &shortLinkRepository{
+		collection: database.Collection("short_links"),
+	}
+}
+
+// Create stores a short link. Its code is unique, so a clash returns a duplicate key error.
+func (r *shortLinkRepository) Create(ctx context.Context, link *models.ShortLink) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, link)
+	if err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			log.Logger.Error("Failed to create short link", zap.String("userId", link.UserID.Hex()), zap.Error(err))
+		}
+		return primitive.NilObjectID, err
+	}
+
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, ErrInvalidInsertedID
+	}
+	return id, nil
+}
+
+func (r *shortLinkRepository) GetByCode(ctx context.Context, code string) (*models.ShortLink, error) {
+	var result models.ShortLink
+	err := r.collection.FindOne(ctx, bson.M{"code": code}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get short link", zap.String("code", code), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *shortLinkRepository) ListByStory(ctx context.Context, storyID primitive.ObjectID) ([]models.ShortLink, error) {
+	opts := options.Find().SetSort(bson.M{"createdAt": 1})
+	cursor, err := r.collection.Find(ctx, bson.M{"storyId": storyID}, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list short links", zap.String("storyId", storyID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.ShortLink
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode short links", zap.String("storyId", storyID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// AttachStory links short links created before publishing to the story they were published in
+func (r *shortLinkRepository) AttachStory(ctx context.Context, ids []primitive.ObjectID, storyID primitive.ObjectID) error {
+	_, err := r.collection.UpdateMany(ctx, bson.M{"_id": bson.M{"$in": ids}}, bson.M{"$set": bson.M{"storyId": storyID}})
+	if err != nil {
+		log.Logger.Error("Failed to attach short links to story", zap.String("storyId", storyID.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *shortLinkRepository) IncrementClicks(ctx context.Context, id primitive.ObjectID, at time.Time) error {
+	update := bson.M{
+		"$inc": bson.M{"clicks": 1},
+		"$set": bson.M{"lastClickedAt": at},
+	}
+	if _, err := r.collection.UpdateByID(ctx, id, update); err != nil {
+		log.Logger.Error("Failed to increment short link clicks", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// CountClicksByStories returns the total clicks of the short links of each story. Stories without
+// links are left out of the map.
+func (r *shortLinkRepository) CountClicksByStories(ctx context.Context, storyIDs []primitive.ObjectID) (map[primitive.ObjectID]int64, error) {
+	counts := make(map[primitive.ObjectID]int64)
+	if len(storyIDs) == 0 {
+		return counts, nil
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"storyId": bson.M{"$in": storyIDs}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$storyId", "clicks": bson.M{"$sum": "$clicks"}}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Logger.Error("Failed to count short link clicks", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var rows []struct {
+		StoryID primitive.ObjectID `bson:"_id"`
+		Clicks  int64              `bson:"clicks"`
+	}
+	if err := cursor.All(ctx, &rows); err != nil {
+		log.Logger.Error("Failed to decode short link clicks", zap.Error(err))
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.StoryID] = row.Clicks
+	}
+	return counts, nil
+}
@@ -20,10 +20,26 @@ var ErrInvalidInsertedID = errors.New("failed to convert InsertedID to ObjectID"
 
 type SocialPostStoriesRepository interface {
 	Create(ctx context.Context, log *models.SocialPostStories) (primitive.ObjectID, error)
+	GetByID(ctx context.Context, id primitive.ObjectID) (*models.SocialPostStories, error)
 	ListByUser(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.SocialPostStories, error)
 	GetByExternalID(ctx context.Context, externalPostID string) (*models.SocialPostStories, error)
 	GetByPostLogID(ctx context.Context, postLogID primitive.ObjectID) (*models.SocialPostStories, error)
-	UpdateStatus(ctx context.Context, id primitive.ObjectID, status string) error
+	GetPublishedByPostLogID(ctx context.Context, postLogID primitive.ObjectID, network string) (*models.SocialPostStories, error)
+	TransitionStatus(ctx context.Context, id primitive.ObjectID, transition models.StatusTransition) (bool, error)
+	// AppendRevision replaces the post content only while it is still revision.Text; false means another edit came first
+	AppendRevision(ctx context.Context, id primitive.ObjectID, revision models.PostRevision, content string) (bool, error)
+	// RevertRevision undoes an AppendRevision whose edit could not be sent to the network
+	RevertRevision(ctx context.Context, id primitive.ObjectID, revision models.PostRevision, content string, editedAt *time.Time) error
+	UpdatePublished(ctx context.Context, id primitive.ObjectID, set bson.M, revision *models.PostRevision) error
+	ListPublishedSince(ctx context.Context, network string, since time.Time) ([]models.SocialPostStories, error)
+	ListPublishedByDraft(ctx context.Context, draftID primitive.ObjectID) ([]models.SocialPostStories, error)
+	ListMeasuredByUser(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.SocialPostStories, error)
+	ListDueForMetricsSync(ctx context.Context, now time.Time, limit int) ([]models.SocialPostStories, error)
+	UpdateMetrics(ctx context.Context, id primitive.ObjectID, metrics *models.EngagementMetrics, syncedAt time.Time, nextSyncAt *time.Time) error
+	SetEvergreen(ctx context.Context, id primitive.ObjectID, settings *models.EvergreenSettings) error
+	ClaimDueEvergreen(ctx context.Context, now time.Time, lease time.Duration) (*models.SocialPostStories, error)
+	RecordEvergreenReshare(ctx context.Context, id primitive.ObjectID, queuedAt time.Time) error
+	ListByOriginal(ctx context.Context, originalStoryID primitive.ObjectID) ([]models.SocialPostStories, error)
 }
 
 type socialPostStoriesRepository struct {
@@ -48,6 +64,14 @@ func NewSocialPostStoriesRepositoryWithDB(database *mongo.Database) SocialPostSt
 }
 
 func (r *socialPostStoriesRepository) Create(ctx context.Context, logEntry *models.SocialPostStories) (primitive.ObjectID, error) {
+	if len(logEntry.StatusHistory) == 0 {
+		logEntry.StatusHistory = []models.StatusTransition{{
+			To:      string(logEntry.Status),
+			ActorID: logEntry.UserID,
+			Reason:  logEntry.Error,
+			At:      logEntry.UpdatedAt,
+		}}
+	}
 	res, err := r.collection.InsertOne(ctx, logEntry)
 	if err != nil {
 		log.Logger.Error("Failed to create social post story", zap.Error(err))
@@ -64,6 +88,20 @@ func (r *socialPostStoriesRepository) Create(ctx context.Context, logEntry *mode
 	return id, nil
 }
 
+func (r *socialPostStoriesRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.SocialPostStories, error) {
+	var result models.SocialPostStories
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get social post story by ID", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (r *socialPostStoriesRepository) ListByUser(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.SocialPostStories, error) {
 	filter := bson.M{"userId": userID}
 	opts := options.Find().SetSort(bson.M{"createdAt": -1})
@@ -119,21 +157,311 @@ func (r *socialPostStoriesRepository) GetByPostLogID(ctx context.Context, postLo
 	return &result, nil
 }
 
-func (r *socialPostStoriesRepository) UpdateStatus(ctx context.Context, id primitive.ObjectID, status string) error {
-	filter := bson.M{"_id": id}
+// GetPublishedByPostLogID returns the most recent successful story of a post on the given network
+func (r *socialPostStoriesRepository) GetPublishedByPostLogID(ctx context.Context, postLogID primitive.ObjectID, network string) (*models.SocialPostStories, error) {
+	filter := bson.M{
+		"postGenerationLogId": postLogID,
+		"network":             network,
+		"status":              models.StoryStatusSuccess,
+	}
+	opts := options.FindOne().SetSort(bson.M{"createdAt": -1})
+
+	var result models.SocialPostStories
+	err := r.collection.FindOne(ctx, filter, opts).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get published social post story by post log ID", zap.Error(err))
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// TransitionStatus moves a story from transition.From to transition.To and appends the transition to its history.
+// It reports false when the story is no longer in transition.From.
+func (r *socialPostStoriesRepository) TransitionStatus(ctx context.Context, id primitive.ObjectID, transition models.StatusTransition) (bool, error) {
+	filter := bson.M{"_id": id, "status": transition.From}
+	update := bson.M{
+		"$set": bson.M{
+			"status":    transition.To,
+			"updatedAt": transition.At,
+		},
+		"$push": bson.M{"statusHistory": transition},
+	}
+
+	res, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Logger.Error("Failed to transition social post story status", zap.Error(err))
+		return false, err
+	}
+	if res.MatchedCount == 0 {
+		return false, nil
+	}
+
+	log.Logger.Info("Social post story status updated", zap.String("id", id.Hex()), zap.String("status", transition.To))
+	return true, nil
+}
+
+// AppendRevision stores the replaced text as a revision and sets the new post content. The update only
+// applies while the post content is still the replaced text, so concurrent edits cannot drop a revision.
+func (r *socialPostStoriesRepository) AppendRevision(ctx context.Context, id primitive.ObjectID, revision models.PostRevision, content string) (bool, error) {
+	filter := bson.M{"_id": id, "postContent": revision.Text}
 	update := bson.M{
+		"$push": bson.M{"revisions": revision},
 		"$set": bson.M{
-			"status":    status,
-			"updatedAt": time.Now().UTC(),
+			"postContent": content,
+			"editedAt":    revision.ReplacedAt,
+			"updatedAt":   time.Now().UTC(),
 		},
 	}
 
-	_, err := r.collection.UpdateOne(ctx, filter, update)
+	res, err := r.collection.UpdateOne(ctx, filter, update)
 	if err != nil {
-		log.Logger.Error("Failed to update social post story status", zap.Error(err))
+		log.Logger.Error("Failed to append social post story revision", zap.String("id", id.Hex()), zap.Error(err))
+		return false, err
+	}
+	if res.MatchedCount == 0 {
+		log.Logger.Warn("Social post story changed before the revision was appended", zap.String("id", id.Hex()))
+		return false, nil
+	}
+
+	log.Logger.Info("Social post story revision appended", zap.String("id", id.Hex()), zap.Int("contentLength", len(content)))
+	return true, nil
+}
+
+// RevertRevision restores the text replaced by AppendRevision, unless the post was edited again since
+func (r *socialPostStoriesRepository) RevertRevision(ctx context.Context, id primitive.ObjectID, revision models.PostRevision, content string, editedAt *time.Time) error {
+	filter := bson.M{"_id": id, "postContent": content}
+	set := bson.M{"postContent": revision.Text, "updatedAt": time.Now().UTC()}
+	update := bson.M{"$pop": bson.M{"revisions": 1}, "$set": set}
+	if editedAt != nil {
+		set["editedAt"] = editedAt
+	} else {
+		update["$unset"] = bson.M{"editedAt": ""}
+	}
+
+	if _, err := r.collection.UpdateOne(ctx, filter, update); err != nil {
+		log.Logger.Error("Failed to revert social post story revision", zap.String("id", id.Hex()), zap.Error(err))
 		return err
 	}
+	return nil
+}
+
+// UpdatePublished sets fields of a published story after it was updated on its network,
+// keeping the previous text as a revision when one is given
+func (r *socialPostStoriesRepository) UpdatePublished(ctx context.Context, id primitive.ObjectID, set bson.M, revision *models.PostRevision) error {
+	fields := bson.M{"updatedAt": time.Now().UTC()}
+	for k, v := range set {
+		fields[k] = v
+	}
+	update := bson.M{"$set": fields}
+	if revision != nil {
+		fields["editedAt"] = revision.ReplacedAt
+		update["$push"] = bson.M{"revisions": revision}
+	}
 
-	log.Logger.Info("Social post story status updated", zap.String("id", id.Hex()), zap.String("status", status))
+	if _, err := r.collection.UpdateByID(ctx, id, update); err != nil {
+		log.Logger.Error("Failed to update published social post story", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
 	return nil
 }
+
+// ListPublishedSince returns the successfully published stories of a network created after since
+func (r *socialPostStoriesRepository) ListPublishedSince(ctx context.Context, network string, since time.Time) ([]models.SocialPostStories, error) {
+	filter := bson.M{
+		"network":        network,
+		"status":         models.StoryStatusSuccess,
+		"externalPostId": bson.M{"$exists": true, "$ne": ""},
+		"createdAt":      bson.M{"$gte": since},
+	}
+	opts := options.Find().SetSort(bson.M{"createdAt": -1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list published social post stories", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.SocialPostStories
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode social post stories", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// ListPublishedByDraft returns the stories successfully published from a draft
+func (r *socialPostStoriesRepository) ListPublishedByDraft(ctx context.Context, draftID primitive.ObjectID) ([]models.SocialPostStories, error) {
+	filter := bson.M{"draftId": draftID, "status": models.StoryStatusSuccess}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		log.Logger.Error("Failed to list stories of draft", zap.String("draftId", draftID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.SocialPostStories
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode social post stories", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// ListMeasuredByUser returns the latest limit successfully published stories of a user that have engagement
+// metrics, with only their publication time and metrics
+func (r *socialPostStoriesRepository) ListMeasuredByUser(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.SocialPostStories, error) {
+	filter := bson.M{
+		"userId":        userID,
+		"status":        models.StoryStatusSuccess,
+		"latestMetrics": bson.M{"$ne": nil},
+	}
+	opts := options.Find().
+		SetSort(bson.M{"createdAt": -1}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"createdAt": 1, "status": 1, "latestMetrics": 1})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list measured social post stories", zap.String("userId", userID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.SocialPostStories
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode social post stories", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// ListDueForMetricsSync returns successfully published stories whose engagement metrics should be refreshed
+func (r *socialPostStoriesRepository) ListDueForMetricsSync(ctx context.Context, now time.Time, limit int) ([]models.SocialPostStories, error) {
+	filter := bson.M{
+		"status":             models.StoryStatusSuccess,
+		"externalPostId":     bson.M{"$exists": true, "$ne": ""},
+		"metricsSyncStopped": bson.M{"$ne": true},
+		"$or": bson.A{
+			bson.M{"nextMetricsSyncAt": bson.M{"$exists": false}},
+			bson.M{"nextMetricsSyncAt": bson.M{"$lte": now}},
+		},
+	}
+	opts := options.Find().SetSort(bson.M{"nextMetricsSyncAt": 1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list social post stories due for metrics sync", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.SocialPostStories
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode social post stories", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// UpdateMetrics stores the latest metrics of a story and schedules its next sync.
+// A nil nextSyncAt stops syncing the story.
+func (r *socialPostStoriesRepository) UpdateMetrics(ctx context.Context, id primitive.ObjectID, metrics *models.EngagementMetrics, syncedAt time.Time, nextSyncAt *time.Time) error {
+	set := bson.M{"metricsSyncedAt": syncedAt}
+	if metrics != nil {
+		set["latestMetrics"] = metrics
+	}
+	if nextSyncAt != nil {
+		set["nextMetricsSyncAt"] = *nextSyncAt
+	} else {
+		set["metricsSyncStopped"] = true
+	}
+
+	_, err := r.collection.UpdateByID(ctx, id, bson.M{"$set": set})
+	if err != nil {
+		log.Logger.Error("Failed to update social post story metrics", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// SetEvergreen stores the evergreen settings of a story
+func (r *socialPostStoriesRepository) SetEvergreen(ctx context.Context, id primitive.ObjectID, settings *models.EvergreenSettings) error {
+	update := bson.M{"$set": bson.M{"evergreen": settings, "updatedAt": time.Now().UTC()}}
+	if _, err := r.collection.UpdateByID(ctx, id, update); err != nil {
+		log.Logger.Error("Failed to set social post story evergreen settings", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ClaimDueEvergreen picks an evergreen story that may be reshared and holds it for lease, so no other
+// run picks it meanwhile. The reshare is only counted by RecordEvergreenReshare once it is queued; a story
+// whose reshare failed to queue is picked again when the lease expires. It returns nil when no story is due.
+func (r *socialPostStoriesRepository) ClaimDueEvergreen(ctx context.Context, now time.Time, lease time.Duration) (*models.SocialPostStories, error) {
+	filter := bson.M{
+		"status":                   models.StoryStatusSuccess,
+		"evergreen.enabled":        true,
+		"evergreen.nextEligibleAt": bson.M{"$lte": now},
+		"$expr":                    bson.M{"$lt": bson.A{"$evergreen.reshares", "$evergreen.maxRepetitions"}},
+	}
+	update := bson.M{"$set": bson.M{"evergreen.nextEligibleAt": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.M{"evergreen.nextEligibleAt": 1}).
+		SetReturnDocument(options.After)
+
+	var result models.SocialPostStories
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to claim evergreen social post story", zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+// RecordEvergreenReshare counts a queued reshare of an evergreen story and makes the next one wait
+// its minimum number of days
+func (r *socialPostStoriesRepository) RecordEvergreenReshare(ctx context.Context, id primitive.ObjectID, queuedAt time.Time) error {
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"evergreen.reshares":     bson.M{"$add": bson.A{"$evergreen.reshares", 1}},
+			"evergreen.lastQueuedAt": queuedAt,
+			"evergreen.nextEligibleAt": bson.M{"$add": bson.A{
+				queuedAt, bson.M{"$multiply": bson.A{"$evergreen.minDaysBetween", int64(24 * time.Hour / time.Millisecond)}},
+			}},
+		}}},
+	}
+	if _, err := r.collection.UpdateByID(ctx, id, update); err != nil {
+		log.Logger.Error("Failed to record evergreen reshare", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ListByOriginal returns the reshares of an evergreen story, oldest first
+func (r *socialPostStoriesRepository) ListByOriginal(ctx context.Context, originalStoryID primitive.ObjectID) ([]models.SocialPostStories, error) {
+	opts := options.Find().SetSort(bson.M{"createdAt": 1})
+	cursor, err := r.collection.Find(ctx, bson.M{"originalStoryId": originalStoryID}, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list social post story reshares", zap.String("originalStoryId", originalStoryID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.SocialPostStories
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode social post stories", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
@@ -2,10 +2,12 @@ package repositories
 
 import (
 	"context"
+	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/postpilot/api/internal/db"
 	"github.com/postpilot/api/internal/log"
@@ -20,6 +22,16 @@ type UserRepository interface {
 	Update(ctx context.Context, user *models.User) error
 	FindByID(ctx context.Context, id string) (*models.User, error)
 	ClearLinkedInToken(ctx context.Context, userID primitive.ObjectID) error
+	ClearDevToApiKey(ctx context.Context, userID primitive.ObjectID) error
+	ListWithLinkedInToken(ctx context.Context, afterID primitive.ObjectID, limit int) ([]models.User, error)
+	SaveLinkedInToken(ctx context.Context, userID primitive.ObjectID, accessToken, refreshToken string, expiresAt, refreshExpiresAt *time.Time) error
+	ConnectLinkedIn(ctx context.Context, userID primitive.ObjectID, personUrn, accessToken, refreshToken string, expiresAt, refreshExpiresAt *time.Time) error
+	SetLinkedInConnectionStatus(ctx context.Context, userID primitive.ObjectID, status models.LinkedInConnectionStatus, checkedAt time.Time) error
+	SetReviewSettings(ctx context.Context, userID primitive.ObjectID, settings *models.ReviewSettings) error
+	ListByReviewer(ctx context.Context, reviewerID primitive.ObjectID) ([]models.User, error)
+	SetCalendarTokenHash(ctx context.Context, userID primitive.ObjectID, tokenHash string) error
+	FindByCalendarTokenHash(ctx context.Context, tokenHash string) (*models.User, error)
+	ListDataSources(ctx context.Context) ([]models.DataSource, error)
 }
 
 type userRepository struct {
@@ -115,9 +127,13 @@ func (r *userRepository) ClearLinkedInToken(ctx context.Context, userID primitiv
 		ctx,
 		bson.M{"_id": userID},
 		bson.M{"$unset": bson.M{
-			"linkedinAccessToken":  "",
-			"linkedinRefreshToken": "",
-			"linkedinPersonUrn":    "",
+			"linkedinAccessToken":           "",
+			"linkedinRefreshToken":          "",
+			"linkedinPersonUrn":             "",
+			"linkedinTokenExpiresAt":        "",
+			"linkedinRefreshTokenExpiresAt": "",
+			"linkedinConnectionStatus":      "",
+			"linkedinConnectionCheckedAt":   "",
 		}},
 	)
 	if err != nil {
@@ -127,3 +143,178 @@ func (r *userRepository) ClearLinkedInToken(ctx context.Context, userID primitiv
 	log.Logger.Info("LinkedIn token cleared", zap.String("userId", userID.Hex()))
 	return nil
 }
+
+// ClearDevToApiKey removes the dev.to API key, which Update cannot do as empty fields are not saved
+func (r *userRepository) ClearDevToApiKey(ctx context.Context, userID primitive.ObjectID) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": userID}, bson.M{"$unset": bson.M{"devToApiKey": ""}})
+	if err != nil {
+		log.Logger.Error("Failed to clear dev.to API key", zap.String("userId", userID.Hex()), zap.Error(err))
+		return err
+	}
+	log.Logger.Info("dev.to API key cleared", zap.String("userId", userID.Hex()))
+	return nil
+}
+
+// ListWithLinkedInToken returns a page of the users with a LinkedIn publishing connection, in ID order,
+// starting after afterID
+func (r *userRepository) ListWithLinkedInToken(ctx context.Context, afterID primitive.ObjectID, limit int) ([]models.User, error) {
+	filter := bson.M{
+		"_id":                 bson.M{"$gt": afterID},
+		"linkedinAccessToken": bson.M{"$exists": true, "$ne": ""},
+	}
+	opts := options.Find().SetSort(bson.M{"_id": 1}).SetLimit(int64(limit))
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list users with LinkedIn token", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []models.User
+	if err := cursor.All(ctx, &users); err != nil {
+		log.Logger.Error("Failed to decode users with LinkedIn token", zap.Error(err))
+		return nil, err
+	}
+	return users, nil
+}
+
+// SaveLinkedInToken stores a new LinkedIn token pair and marks the connection healthy.
+// An empty refresh token keeps the stored one, since LinkedIn does not always rotate it.
+func (r *userRepository) SaveLinkedInToken(ctx context.Context, userID primitive.ObjectID, accessToken, refreshToken string, expiresAt, refreshExpiresAt *time.Time) error {
+	return r.saveLinkedInToken(ctx, userID, bson.M{}, accessToken, refreshToken, expiresAt, refreshExpiresAt, false)
+}
+
+// ConnectLinkedIn stores the token of a new authorization and the member it belongs to. Unlike SaveLinkedInToken,
+// whatever the new token lacks is removed, so nothing of a previous connection survives.
+func (r *userRepository) ConnectLinkedIn(ctx context.Context, userID primitive.ObjectID, personUrn, accessToken, refreshToken string, expiresAt, refreshExpiresAt *time.Time) error {
+	return r.saveLinkedInToken(ctx, userID, bson.M{"linkedinPersonUrn": personUrn}, accessToken, refreshToken, expiresAt, refreshExpiresAt, true)
+}
+
+func (r *userRepository) saveLinkedInToken(ctx context.Context, userID primitive.ObjectID, set bson.M, accessToken, refreshToken string, expiresAt, refreshExpiresAt *time.Time, replace bool) error {
+	now := time.Now().UTC()
+	set["linkedinAccessToken"] = accessToken
+	set["linkedinConnectionStatus"] = models.LinkedInConnected
+	set["linkedinConnectionCheckedAt"] = now
+	set["updatedAt"] = now
+	unset := bson.M{}
+	if expiresAt != nil {
+		set["linkedinTokenExpiresAt"] = *expiresAt
+	} else {
+		unset["linkedinTokenExpiresAt"] = ""
+	}
+	if refreshToken != "" {
+		set["linkedinRefreshToken"] = refreshToken
+		if refreshExpiresAt != nil {
+			set["linkedinRefreshTokenExpiresAt"] = *refreshExpiresAt
+		} else {
+			unset["linkedinRefreshTokenExpiresAt"] = ""
+		}
+	} else if replace {
+		unset["linkedinRefreshToken"] = ""
+		unset["linkedinRefreshTokenExpiresAt"] = ""
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": userID}, update)
+	if err != nil {
+		log.Logger.Error("Failed to save LinkedIn token", zap.String("userId", userID.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *userRepository) SetLinkedInConnectionStatus(ctx context.Context, userID primitive.ObjectID, status models.LinkedInConnectionStatus, checkedAt time.Time) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": userID},
+		bson.M{"$set": bson.M{
+			"linkedinConnectionStatus":    status,
+			"linkedinConnectionCheckedAt": checkedAt,
+		}},
+	)
+	if err != nil {
+		log.Logger.Error("Failed to update LinkedIn connection status", zap.String("userId", userID.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *userRepository) SetReviewSettings(ctx context.Context, userID primitive.ObjectID, settings *models.ReviewSettings) error {
+	_, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": userID},
+		bson.M{"$set": bson.M{"review": settings, "updatedAt": time.Now().UTC()}},
+	)
+	if err != nil {
+		log.Logger.Error("Failed to update review settings", zap.String("userId", userID.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ListByReviewer returns the users whose posts reviewerID is assigned to review
+func (r *userRepository) ListByReviewer(ctx context.Context, reviewerID primitive.ObjectID) ([]models.User, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"review.enabled": true, "review.reviewerIds": reviewerID})
+	if err != nil {
+		log.Logger.Error("Failed to list users by reviewer", zap.String("reviewerId", reviewerID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var users []models.User
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// SetCalendarTokenHash replaces the hash of the user's calendar feed token; an empty hash revokes the feed
+func (r *userRepository) SetCalendarTokenHash(ctx context.Context, userID primitive.ObjectID, tokenHash string) error {
+	update := bson.M{"$set": bson.M{"calendarTokenHash": tokenHash, "updatedAt": time.Now().UTC()}}
+	if tokenHash == "" {
+		update = bson.M{"$unset": bson.M{"calendarTokenHash": ""}, "$set": bson.M{"updatedAt": time.Now().UTC()}}
+	}
+	if _, err := r.collection.UpdateOne(ctx, bson.M{"_id": userID}, update); err != nil {
+		log.Logger.Error("Failed to update calendar token", zap.String("userId", userID.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *userRepository) FindByCalendarTokenHash(ctx context.Context, tokenHash string) (*models.User, error) {
+	user := &models.User{}
+	err := r.collection.FindOne(ctx, bson.M{"calendarTokenHash": tokenHash}).Decode(user)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to find user by calendar token", zap.Error(err))
+		return nil, err
+	}
+	return user, nil
+}
+
+// ListDataSources returns the data sources configured by every user, with repetitions
+func (r *userRepository) ListDataSources(ctx context.Context) ([]models.DataSource, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"dataSources.0": bson.M{"$exists": true}}}},
+		{{Key: "$unwind", Value: "$dataSources"}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$dataSources"}}},
+	}
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Logger.Error("Failed to list data sources", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var sources []models.DataSource
+	if err := cursor.All(ctx, &sources); err != nil {
+		log.Logger.Error("Failed to decode data sources", zap.Error(err))
+		return nil, err
+	}
+	return sources, nil
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type WebhookDeliveryRepository interface {
+	Create(ctx context.Context, delivery *models.WebhookDelivery) (primitive.ObjectID, error)
+	ListByWebhook(ctx context.Context, webhookID primitive.ObjectID, limit int) ([]models.WebhookDelivery, error)
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*models.WebhookDelivery, error)
+	Update(ctx context.Context, id primitive.ObjectID, set bson.M, unset bson.M) error
+	DeleteByWebhook(ctx context.Context, webhookID primitive.ObjectID) error
+}
+
+type webhookDeliveryRepository struct {
+	collection *mongo.Collection
+}
+
+// NewWebhookDeliveryRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewWebhookDeliveryRepositoryWithDB(database *mongo.Database) WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		collection: database.Collection("webhook_deliveries"),
+	}
+}
+
+func (r *webhookDeliveryRepository) Create(ctx context.Context, delivery *models.WebhookDelivery) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, delivery)
+	if err != nil {
+		log.Logger.Error("Failed to create webhook delivery", zap.String("webhookId", delivery.WebhookID.Hex()), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, ErrInvalidInsertedID
+	}
+	return id, nil
+}
+
+func (r *webhookDeliveryRepository) ListByWebhook(ctx context.Context, webhookID primitive.ObjectID, limit int) ([]models.WebhookDelivery, error) {
+	opts := options.Find().SetSort(bson.M{"createdAt": -1})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"webhookId": webhookID}, opts)
+	if err != nil {
+		log.Logger.Error("Failed to list webhook deliveries", zap.String("webhookId", webhookID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.WebhookDelivery
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode webhook deliveries", zap.String("webhookId", webhookID.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// ClaimDue atomically takes the oldest pending delivery whose attempt is due, pushing its next attempt
+// back by lease so that concurrent workers do not send it twice. It returns nil when nothing is due.
+func (r *webhookDeliveryRepository) ClaimDue(ctx context.Context, now time.Time, lease time.Duration) (*models.WebhookDelivery, error) {
+	filter := bson.M{
+		"status":        models.WebhookDeliveryPending,
+		"nextAttemptAt": bson.M{"$lte": now},
+	}
+	update := bson.M{"$set": bson.M{"nextAttemptAt": now.Add(lease)}}
+	opts := options.FindOneAndUpdate().SetSort(bson.M{"nextAttemptAt": 1})
+
+	var result models.WebhookDelivery
+	err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to claim webhook delivery", zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *webhookDeliveryRepository) Update(ctx context.Context, id primitive.ObjectID, set bson.M, unset bson.M) error {
+	set["updatedAt"] = time.Now().UTC()
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		log.Logger.Error("Failed to update webhook delivery", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepository) DeleteByWebhook(ctx context.Context, webhookID primitive.ObjectID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"webhookId": webhookID})
+	if err != nil {
+		log.Logger.Error("Failed to delete webhook deliveries", zap.String("webhookId", webhookID.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+type WebhookRepository interface {
+	Create(ctx context.Context, webhook *models.Webhook) (primitive.ObjectID, error)
+	GetByID(ctx context.Context, id primitive.ObjectID) (*models.Webhook, error)
+	ListByUser(ctx context.Context, userID primitive.ObjectID) ([]models.Webhook, error)
+	ListActiveByUser(ctx context.Context, userID primitive.ObjectID) ([]models.Webhook, error)
+	Update(ctx context.Context, id primitive.ObjectID, set bson.M) (*models.Webhook, error)
+	Delete(ctx context.Context, id primitive.ObjectID) error
+}
+
+type webhookRepository struct {
+	collection *mongo.Collection
+}
+
+// NewWebhookRepositoryWithDB creates repository with injected database (for Wire DI)
+func NewWebhookRepositoryWithDB(database *mongo.Database) WebhookRepository {
+	return &webhookRepository{
+		collection: database.Collection("webhooks"),
+	}
+}
+
+func (r *webhookRepository) Create(ctx context.Context, webhook *models.Webhook) (primitive.ObjectID, error) {
+	res, err := r.collection.InsertOne(ctx, webhook)
+	if err != nil {
+		log.Logger.Error("Failed to create webhook", zap.String("userId", webhook.UserID.Hex()), zap.Error(err))
+		return primitive.NilObjectID, err
+	}
+	id, ok := res.InsertedID.(primitive.ObjectID)
+	if !ok {
+		return primitive.NilObjectID, ErrInvalidInsertedID
+	}
+	return id, nil
+}
+
+func (r *webhookRepository) GetByID(ctx context.Context, id primitive.ObjectID) (*models.Webhook, error) {
+	var result models.Webhook
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to get webhook", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *webhookRepository) ListByUser(ctx context.Context, userID primitive.ObjectID) ([]models.Webhook, error) {
+	return r.find(ctx, bson.M{"userId": userID})
+}
+
+func (r *webhookRepository) ListActiveByUser(ctx context.Context, userID primitive.ObjectID) ([]models.Webhook, error) {
+	return r.find(ctx, bson.M{"userId": userID, "active": true})
+}
+
+func (r *webhookRepository) find(ctx context.Context, filter bson.M) ([]models.Webhook, error) {
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.M{"createdAt": 1}))
+	if err != nil {
+		log.Logger.Error("Failed to list webhooks", zap.Error(err))
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	var results []models.Webhook
+	if err := cursor.All(ctx, &results); err != nil {
+		log.Logger.Error("Failed to decode webhooks", zap.Error(err))
+		return nil, err
+	}
+	return results, nil
+}
+
+// Update applies set to the webhook and returns the updated document, or nil if it does not exist
+func (r *webhookRepository) Update(ctx context.Context, id primitive.ObjectID, set bson.M) (*models.Webhook, error) {
+	fields := bson.M{"updatedAt": time.Now().UTC()}
+	for k, v := range set {
+		fields[k] = v
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var result models.Webhook
+	err := r.collection.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{"$set": fields}, opts).Decode(&result)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Logger.Error("Failed to update webhook", zap.String("id", id.Hex()), zap.Error(err))
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (r *webhookRepository) Delete(ctx context.Context, id primitive.ObjectID) error {
+	_, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		log.Logger.Error("Failed to delete webhook", zap.String("id", id.Hex()), zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,306 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/postpilot/api/internal/httpclient"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+const (
+	// articleResolveTimeout bounds the request made to an article to follow redirects and read its canonical link
+	articleResolveTimeout = 8 * time.Second
+	// articleResolveMaxBytes is how much of an article page is read looking for its canonical link
+	articleResolveMaxBytes = 256 << 10
+	maxConcurrentResolves  = 8
+
+	// titleMatchWindow is how far apart two articles may be published to be compared by title
+	titleMatchWindow = 72 * time.Hour
+	// titleMatchCandidates bounds the articles a new one is compared with
+	titleMatchCandidates = 2000
+	// titleMatchThreshold is the minimum share of words two titles must have in common to be the same story
+	titleMatchThreshold = 0.8
+	// clusterBatchSize is how many new articles are clustered per pass
+	clusterBatchSize = 500
+	// clusterLeaseName and clusterLease are the lease that keeps clustering to one instance at a time; it is
+	// renewed for every batch
+	clusterLeaseName = "articles-cluster"
+	clusterLease     = 5 * time.Minute
+)
+
+// articleHTTPClient reads the pages of articles and data sources, which come from users and feeds, so it
+// only connects to public addresses
+var articleHTTPClient = httpclient.NewPublic(nil).Client()
+
+// trackingParams are query parameters that only identify where a click came from
+var trackingParams = map[string]bool{
+	"fbclid": true, "gclid": true, "dclid": true, "msclkid": true, "yclid": true, "igshid": true,
+	"mc_cid": true, "mc_eid": true, "_hsenc": true, "_hsmi": true, "mkt_tok": true,
+	"ref": true, "ref_src": true, "ref_url": true, "source": true, "share": true,
+}
+
+// titleStopWords are left out when comparing titles, in English and Portuguese
+var titleStopWords = map[string]bool{
+	"a": true, "an": true, "the": true, "of": true, "to": true, "in": true, "on": true, "for": true,
+	"and": true, "or": true, "with": true, "is": true, "are": true, "how": true, "why": true,
+	"o": true, "os": true, "as": true, "um": true, "uma": true, "de": true, "do": true, "da": true,
+	"dos": true, "das": true, "e": true, "em": true, "no": true, "na": true, "para": true, "com": true,
+	"por": true, "que": true,
+}
+
+// titlePrefix matches the prefixes aggregators add to titles, like "Show HN:"
+var titlePrefix = regexp.MustCompile(`(?i)^\s*(show|ask|tell|launch)\s+hn\s*[:\-–]\s*`)
+
+// MergedArticle is a story read from one or more data sources, with the sources it was found in
+type MergedArticle struct {
+	ID           primitive.ObjectID    `json:"id"`
+	Title        string                `json:"title"`
+	Url          string                `json:"url"`
+	CanonicalURL string                `json:"canonicalUrl"`
+	Source       string                `json:"source"`
+	PublishedAt  time.Time             `json:"publishedAt"`
+	Summary      string                `json:"summary,omitempty"`
+	Tags         []string              `json:"tags,omitempty"`
+	Sources      []MergedArticleSource `json:"sources"`
+	Score        float64               `json:"score,omitempty"` // text search relevance
+}
+
+// MergedArticleSource is a data source a merged article was found in and its URL there
+type MergedArticleSource struct {
+	Type models.DataSourceType `json:"type" example:"hackernews"`
+	Name string                `json:"name" example:"Hacker News"`
+	Url  string                `json:"url"`
+}
+
+// mergeCluster turns the articles of a story into one. The newest article gives the title and URL;
+// the summary comes from the first article that has one.
+func mergeCluster(cluster models.ArticleCluster) MergedArticle {
+	first := cluster.Articles[0]
+	merged := MergedArticle{
+		ID:           cluster.ID,
+		Title:        first.Title,
+		Url:          first.Url,
+		CanonicalURL: first.CanonicalURL,
+		Source:       first.Source,
+		PublishedAt:  first.PublishedAt,
+		Sources:      []MergedArticleSource{},
+		Score:        cluster.Score,
+	}
+
+	seenTags := make(map[string]bool)
+	seenSources := make(map[string]bool)
+	for _, a := range cluster.Articles {
+		if merged.Summary == "" && a.Summary != "" {
+			merged.Summary = a.Summary
+		}
+		for _, tag := range a.Tags {
+			if !seenTags[tag] {
+				seenTags[tag] = true
+				merged.Tags = append(merged.Tags, tag)
+			}
+		}
+		if key := string(a.SourceType) + "|" + a.Source + "|" + a.Url; !seenSources[key] {
+			seenSources[key] = true
+			merged.Sources = append(merged.Sources, MergedArticleSource{Type: a.SourceType, Name: a.Source, Url: a.Url})
+		}
+	}
+	return merged
+}
+
+// canonicalURLs returns the canonical URL of each article URL. URLs already stored keep their canonical
+// URL; new ones are requested to follow redirects and read their <link rel="canonical">.
+func (s *articleService) canonicalURLs(ctx context.Context, urls []string) map[string]string {
+	known, err := s.articleRepository.CanonicalURLs(ctx, urls)
+	if err != nil {
+		known = map[string]string{}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentResolves)
+	for _, raw := range urls {
+		if _, ok := known[raw]; ok {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(raw string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			canonical := resolveArticleURL(ctx, raw)
+			mu.Lock()
+			known[raw] = canonical
+			mu.Unlock()
+		}(raw)
+	}
+	wg.Wait()
+	return known
+}
+
+// resolveArticleURL follows the redirects of an article URL and prefers the canonical link of the page.
+// On any failure the URL itself is canonicalized.
+func resolveArticleURL(ctx context.Context, raw string) string {
+	ctx, cancel := context.WithTimeout(ctx, articleResolveTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, raw, nil)
+	if err != nil {
+		return canonicalArticleURL(raw)
+	}
+	req.Header.Set("User-Agent", feedUserAgent)
+	req.Header.Set("Accept", "text/html")
+	resp, err := articleHTTPClient.Do(req)
+	if err != nil {
+		log.Logger.Debug("Failed to resolve article URL", zap.String("url", raw), zap.Error(err))
+		return canonicalArticleURL(raw)
+	}
+	defer resp.Body.Close()
+
+	final := resp.Request.URL
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return canonicalArticleURL(raw)
+	}
+	if !strings.Contains(resp.Header.Get("Content-Type"), "html") {
+		return canonicalArticleURL(final.String())
+	}
+
+	doc, err := goquery.NewDocumentFromReader(io.LimitReader(resp.Body, articleResolveMaxBytes))
+	if err != nil {
+		return canonicalArticleURL(final.String())
+	}
+	href, ok := doc.Find(`link[rel="canonical"]`).First().Attr("href")
+	if !ok || strings.TrimSpace(href) == "" {
+		return canonicalArticleURL(final.String())
+	}
+	canonical, err := final.Parse(strings.TrimSpace(href))
+	// Some sites point every page to their home page; such a link says nothing about the article. Links to
+	// internal addresses are not trusted either.
+	if err != nil || httpclient.ValidatePublicURL(canonical.String()) != nil || (strings.Trim(canonical.Path, "/") == "" && strings.Trim(final.Path, "/") != "") {
+		return canonicalArticleURL(final.String())
+	}
+	return canonicalArticleURL(canonical.String())
+}
+
+// canonicalArticleURL normalizes an article URL and drops its tracking parameters
+func canonicalArticleURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return normalizeFeedURL(raw)
+	}
+	query := u.Query()
+	for param := range query {
+		if strings.HasPrefix(strings.ToLower(param), "utm_") || trackingParams[strings.ToLower(param)] {
+			query.Del(param)
+		}
+	}
+	u.RawQuery = query.Encode()
+	u.Host = strings.TrimPrefix(strings.ToLower(u.Host), "www.")
+	return normalizeFeedURL(u.String())
+}
+
+// clusterNew assigns a cluster to the articles that have none: the cluster of another article with the
+// same canonical URL or, failing that, of an article published around the same time with a similar
+// title. Articles are handled one at a time so each one sees the clusters of the previous ones, and by
+// one instance at a time; while another one holds the lease, the new articles are left to it or to the next run.
+func (s *articleService) clusterNew(ctx context.Context) error {
+	owner := primitive.NewObjectID().Hex()
+	defer func() {
+		_ = s.leaseRepository.Release(context.WithoutCancel(ctx), clusterLeaseName, owner)
+	}()
+
+	for ctx.Err() == nil {
+		held, err := s.leaseRepository.Acquire(ctx, clusterLeaseName, owner, time.Now().UTC(), clusterLease)
+		if err != nil {
+			return err
+		}
+		if !held {
+			log.Logger.Debug("Article clustering already running on another instance")
+			return nil
+		}
+
+		articles, err := s.articleRepository.ListUnclustered(ctx, clusterBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, a := range articles {
+			clusterID, err := s.findCluster(ctx, a)
+			if err != nil {
+				return err
+			}
+			if err := s.articleRepository.SetClusterID(ctx, a.ID, clusterID); err != nil {
+				return err
+			}
+		}
+		if len(articles) < clusterBatchSize {
+			break
+		}
+	}
+	return nil
+}
+
+func (s *articleService) findCluster(ctx context.Context, a models.IngestedArticle) (primitive.ObjectID, error) {
+	clusterID, err := s.articleRepository.FindClusterByCanonicalURL(ctx, a.CanonicalURL, a.ID)
+	if err != nil || !clusterID.IsZero() {
+		return clusterID, err
+	}
+
+	words := titleWords(a.Title)
+	if len(words) > 0 {
+		candidates, err := s.articleRepository.ListClusteredBetween(ctx, a.PublishedAt.Add(-titleMatchWindow), a.PublishedAt.Add(titleMatchWindow), titleMatchCandidates)
+		if err != nil {
+			return primitive.NilObjectID, err
+		}
+		for _, c := range candidates {
+			if c.ID != a.ID && titlesMatch(words, titleWords(c.Title)) {
+				return c.ClusterID, nil
+			}
+		}
+	}
+	return a.ID, nil
+}
+
+// titleWords returns the distinct significant words of a title, lowercased
+func titleWords(title string) map[string]bool {
+	title = titlePrefix.ReplaceAllString(title, "")
+	fields := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	words := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if !titleStopWords[f] {
+			words[f] = true
+		}
+	}
+	return words
+}
+
+// titlesMatch tells whether two titles are the same story: identical words for short titles, or at
+// least titleMatchThreshold of the words in common (Jaccard) for titles of three or more words
+func titlesMatch(a, b map[string]bool) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return false
+	}
+	common := 0
+	for w := range a {
+		if b[w] {
+			common++
+		}
+	}
+	union := len(a) + len(b) - common
+	if len(a) < 3 || len(b) < 3 {
+		return common == union
+	}
+	return float64(common)/float64(union) >= titleMatchThreshold
+}
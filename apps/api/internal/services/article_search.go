@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var ErrInvalidArticleCursor = errors.New("invalid cursor")
+
+// Languages of the text index: ingested articles are stemmed in one of them
+const (
+	ArticleLanguagePortuguese = "pt"
+	ArticleLanguageEnglish    = "en"
+)
+
+// languageHints are frequent words that tell Portuguese from English text
+var languageHints = map[string]map[string]bool{
+	ArticleLanguagePortuguese: toSet("de", "do", "da", "em", "um", "nos", "nas", "que", "não", "para", "com", "uma", "os", "das", "dos", "como", "mais", "por", "ao", "é", "são", "você", "seu", "sua", "isso", "este", "esta", "também", "sobre", "quando"),
+	ArticleLanguageEnglish:    toSet("the", "and", "of", "to", "is", "for", "with", "how", "what", "you", "your", "are", "this", "that", "from", "why", "when", "about", "it", "in", "on"),
+}
+
+// ArticleSearchQuery is a page of a search over the articles of the user's data sources.
+// An empty Sort orders by relevance when Q is set and by newest otherwise.
+type ArticleSearchQuery struct {
+	Q           string
+	Language    string
+	SourceTypes []models.DataSourceType
+	Source      string
+	Tags        []string
+	From        *time.Time
+	To          *time.Time
+	Sort        models.ArticleSort
+	Cursor      string
+	Limit       int
+}
+
+// ArticleSearchResult is a page of search results. NextCursor is empty on the last page.
+type ArticleSearchResult struct {
+	Articles   []MergedArticle `json:"articles"`
+	NextCursor string          `json:"nextCursor,omitempty"`
+}
+
+// articleCursor is the JSON inside a search cursor. The sort is kept so a cursor is not reused with another order.
+type articleCursor struct {
+	Sort        models.ArticleSort `json:"o"`
+	Score       float64            `json:"s,omitempty"`
+	PublishedAt time.Time          `json:"p"`
+	ID          string             `json:"i"`
+}
+
+// Search runs a text search over the articles of the user's data sources. Words are stemmed in the given
+// language or, when none is given, in the language the query looks to be written in.
+func (s *articleService) Search(ctx context.Context, user *models.User, query ArticleSearchQuery) (*ArticleSearchResult, error) {
+	query.Q = strings.TrimSpace(query.Q)
+	if query.Sort == "" || (query.Sort == models.ArticleSortRelevance && query.Q == "") {
+		query.Sort = models.ArticleSortNewest
+		if query.Q != "" {
+			query.Sort = models.ArticleSortRelevance
+		}
+	}
+	after, err := decodeArticleCursor(query.Cursor, query.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ArticleSearchResult{Articles: []MergedArticle{}}
+	sources, _ := s.userSources(user)
+	if len(sources) == 0 {
+		return result, nil
+	}
+	keys := make([]string, len(sources))
+	for i, us := range sources {
+		keys[i] = us.key
+	}
+
+	language := query.Language
+	if language == "" && query.Q != "" {
+		language = detectLanguage(query.Q)
+	}
+	search := repositories.ArticleSearch{
+		Filter: repositories.ArticleFilter{
+			SourceKeys:  keys,
+			SourceTypes: query.SourceTypes,
+			Source:      query.Source,
+			Tags:        query.Tags,
+			From:        query.From,
+			To:          query.To,
+		},
+		Text:     query.Q,
+		Language: language,
+		Sort:     query.Sort,
+		After:    after,
+		Limit:    query.Limit + 1, // one extra story tells whether there is a next page
+	}
+	clusters, err := s.articleRepository.Search(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) > query.Limit {
+		clusters = clusters[:query.Limit]
+		last := clusters[len(clusters)-1]
+		result.NextCursor = encodeArticleCursor(articleCursor{
+			Sort:        query.Sort,
+			Score:       last.Score,
+			PublishedAt: last.PublishedAt,
+			ID:          last.ID.Hex(),
+		})
+	}
+	for _, cluster := range clusters {
+		result.Articles = append(result.Articles, mergeCluster(cluster))
+	}
+	return result, nil
+}
+
+func encodeArticleCursor(c articleCursor) string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeArticleCursor(raw string, sort models.ArticleSort) (*repositories.ArticleCursor, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidArticleCursor
+	}
+	var c articleCursor
+	if err := json.Unmarshal(data, &c); err != nil || c.Sort != sort {
+		return nil, ErrInvalidArticleCursor
+	}
+	id, err := primitive.ObjectIDFromHex(c.ID)
+	if err != nil {
+		return nil, ErrInvalidArticleCursor
+	}
+	return &repositories.ArticleCursor{Score: c.Score, PublishedAt: c.PublishedAt, ID: id}, nil
+}
+
+// detectLanguage guesses whether a text is Portuguese or English from its frequent words and accents,
+// defaulting to English
+func detectLanguage(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	pt, en := 0, 0
+	for _, w := range words {
+		if languageHints[ArticleLanguagePortuguese][w] {
+			pt++
+		}
+		if languageHints[ArticleLanguageEnglish][w] {
+			en++
+		}
+		if strings.ContainsAny(w, "ãõçáéíóúâêô") {
+			pt++
+		}
+	}
+	if pt > en {
+		return ArticleLanguagePortuguese
+	}
+	return ArticleLanguageEnglish
+}
+
+func toSet(values ...string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
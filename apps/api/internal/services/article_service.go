@@ -2,312 +2,291 @@ package services
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
 	"sync"
 	"time"
 
-	"github.com/mmcdole/gofeed"
 	"github.com/postpilot/api/internal/log"
 	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
 	"go.uber.org/zap"
 )
 
-type Article struct {
-	Title       string    `json:"title"`
-	Url         string    `json:"url"`
-	Source      string    `json:"source"`
-	PublishedAt time.Time `json:"publishedAt"`
-	Summary     string    `json:"summary,omitempty"`
-	Tags        []string  `json:"tags,omitempty"`
-}
+// articlesPerSource is how many of the latest articles are read from each data source
+const articlesPerSource = 50
 
-type ArticleService interface {
-	FetchSuggestions(ctx context.Context, user *models.User, q string, from, to *time.Time, tags []string, limit int) ([]Article, error)
-}
+// maxConcurrentIngests bounds the data sources read at the same time by the ingestion job
+const maxConcurrentIngests = 4
 
-type articleService struct{}
+// newSourcesIngestTimeout bounds the background read of the sources found by a request that were never ingested
+const newSourcesIngestTimeout = 2 * time.Minute
 
-func NewArticleService() ArticleService {
-	return &articleService{}
+// SourceError reports a data source that could not be read
+type SourceError struct {
+	Type  models.DataSourceType `json:"type" example:"rss"`
+	Url   string                `json:"url,omitempty"`
+	Error string                `json:"error"`
 }
 
-// FetchSuggestions busca e normaliza artigos das fontes do usuário
-func (s *articleService) FetchSuggestions(ctx context.Context, user *models.User, q string, from, to *time.Time, tags []string, limit int) ([]Article, error) {
-	// Buscar mais artigos por fonte para garantir variedade antes de filtrar
-	const maxFetchPerSource = 50
-	allArticles := s.fetchFromAllSources(ctx, user, maxFetchPerSource)
-	return s.filterArticles(allArticles, q, from, to, tags, limit), nil
+// SuggestionsQuery filters and pages the suggested articles. Page starts at 1.
+type SuggestionsQuery struct {
+	Q     string
+	From  *time.Time
+	To    *time.Time
+	Tags  []string
+	Page  int
+	Limit int
 }
 
-func (s *articleService) fetchFromAllSources(ctx context.Context, user *models.User, limit int) []Article {
-	numSources := len(user.DataSources)
-	if numSources == 0 {
-		return nil
-	}
-
-	type result struct {
-		idx      int
-		articles []Article
-	}
+// ArticleSuggestions holds a page of suggested articles, with the copies of a story read from several
+// sources merged into one, and the data sources that failed to load. PendingSources counts the sources
+// being read for the first time, whose articles are not in the page yet.
+type ArticleSuggestions struct {
+	Articles       []MergedArticle `json:"articles"`
+	SourceErrors   []SourceError   `json:"sourceErrors"`
+	PendingSources int             `json:"pendingSources"`
+	Page           int             `json:"page"`
+	HasMore        bool            `json:"hasMore"`
+}
 
-	resultsCh := make(chan result, numSources)
-	for i, ds := range user.DataSources {
-		go func(idx int, ds models.DataSource) {
-			articles := s.fetchFromSource(ctx, ds, limit)
-			resultsCh <- result{idx: idx, articles: articles}
-		}(i, ds)
-	}
+type ArticleService interface {
+	FetchSuggestions(ctx context.Context, user *models.User, query SuggestionsQuery) (*ArticleSuggestions, error)
+	Search(ctx context.Context, user *models.User, query ArticleSearchQuery) (*ArticleSearchResult, error)
+	Ingest(ctx context.Context) error
+}
 
-	articlesBySource := make([][]Article, numSources)
-	for i := 0; i < numSources; i++ {
-		res := <-resultsCh
-		articlesBySource[res.idx] = res.articles
-	}
+type articleService struct {
+	articleRepository   repositories.ArticleRepository
+	feedCacheRepository repositories.FeedCacheRepository
+	userRepository      repositories.UserRepository
+	leaseRepository     repositories.JobLeaseRepository
+	feedCache           *feedCache
+	// newSources holds the keys of the sources this instance is reading for the first time
+	newSources sync.Map
+}
 
-	// Intercala os artigos (round-robin)
-	var diversified []Article
-	for i := 0; len(diversified) < limit; i++ {
-		added := false
-		for _, sourceArticles := range articlesBySource {
-			if i < len(sourceArticles) {
-				diversified = append(diversified, sourceArticles[i])
-				if len(diversified) >= limit {
-					break
-				}
-				added = true
-			}
-		}
-		if !added {
-			break // Nenhum artigo novo adicionado, pode sair
-		}
+func NewArticleService(
+	articleRepo repositories.ArticleRepository,
+	feedCacheRepo repositories.FeedCacheRepository,
+	userRepo repositories.UserRepository,
+	leaseRepo repositories.JobLeaseRepository,
+) ArticleService {
+	return &articleService{
+		articleRepository:   articleRepo,
+		feedCacheRepository: feedCacheRepo,
+		userRepository:      userRepo,
+		leaseRepository:     leaseRepo,
+		feedCache:           newFeedCache(feedCacheRepo),
 	}
-	return diversified
 }
 
-func (s *articleService) fetchFromSource(ctx context.Context, ds models.DataSource, limit int) []Article {
-	switch ds.Type {
-	case models.DataSourceRSS:
-		articles, err := fetchArticlesFromRSS(ctx, ds.Url, limit)
-		if err == nil {
-			return articles
-		}
-	case models.DataSourceDevTo:
-		articles, err := fetchArticlesFromDevTo(ctx, ds.Tags, limit)
-		if err == nil {
-			return articles
-		}
-	case models.DataSourceHackerNews:
-		articles, err := fetchArticlesFromHackerNews(ctx, limit)
-		if err == nil {
-			return articles
-		}
-	}
-	return nil
+// userSource is a data source of a user along with its registered type and cache key
+type userSource struct {
+	ds     models.DataSource
+	source ArticleSource
+	key    string
 }
 
-func (s *articleService) filterArticles(articles []Article, q string, from, to *time.Time, tags []string, limit int) []Article {
-	filtered := make([]Article, 0)
-	for _, art := range articles {
-		if !s.matchesFilters(art, q, from, to, tags) {
-			continue
-		}
-		filtered = append(filtered, art)
+// FetchSuggestions returns the ingested articles of the user's data sources, newest first. Sources that
+// were never ingested are read in the background right away, so a newly added source shows up in the
+// following requests without waiting for the job.
+func (s *articleService) FetchSuggestions(ctx context.Context, user *models.User, query SuggestionsQuery) (*ArticleSuggestions, error) {
+	sources, sourceErrors := s.userSources(user)
+	resp := &ArticleSuggestions{Articles: []MergedArticle{}, SourceErrors: sourceErrors, Page: query.Page}
+	if len(sources) == 0 {
+		return resp, nil
 	}
-	if len(filtered) > limit {
-		filtered = filtered[:limit]
-	}
-	return filtered
-}
 
-func (s *articleService) matchesFilters(art Article, q string, from, to *time.Time, tags []string) bool {
-	if q != "" && !containsIgnoreCase(art.Title, q) && !containsIgnoreCase(art.Summary, q) {
-		return false
+	keys := make([]string, len(sources))
+	for i, us := range sources {
+		keys[i] = us.key
 	}
-	if from != nil && art.PublishedAt.Before(*from) {
-		return false
+	entries, err := s.feedCacheRepository.GetMany(ctx, keys)
+	if err != nil {
+		return nil, err
 	}
-	if to != nil && art.PublishedAt.After(*to) {
-		return false
+	entriesByKey := make(map[string]models.FeedCacheEntry, len(entries))
+	for _, entry := range entries {
+		entriesByKey[entry.Key] = entry
 	}
-	if len(tags) > 0 && !hasAnyTag(art.Tags, tags) {
-		return false
+
+	var pending []userSource
+	for _, us := range sources {
+		entry, ok := entriesByKey[us.key]
+		switch {
+		case !ok:
+			pending = append(pending, us)
+		case entry.LastError != "":
+			resp.SourceErrors = append(resp.SourceErrors, SourceError{Type: us.ds.Type, Url: us.ds.Url, Error: entry.LastError})
+		}
 	}
-	return true
-}
+	resp.PendingSources = len(pending)
+	s.ingestNewSources(pending)
 
-func fetchArticlesFromRSS(ctx context.Context, url string, limit int) ([]Article, error) {
-	parser := gofeed.NewParser()
-	feed, err := parser.ParseURLWithContext(url, ctx)
+	filter := repositories.ArticleFilter{
+		SourceKeys: keys,
+		Query:      query.Q,
+		Tags:       query.Tags,
+		From:       query.From,
+		To:         query.To,
+	}
+	// One extra story tells whether there is a next page
+	clusters, err := s.articleRepository.FindClusters(ctx, filter, (query.Page-1)*query.Limit, query.Limit+1)
 	if err != nil {
-		log.Logger.Error("Failed to fetch RSS feed", zap.String("url", url), zap.Error(err))
 		return nil, err
 	}
-	articles := make([]Article, 0, limit)
-	for i, item := range feed.Items {
-		if i >= limit {
-			break
-		}
-		published := time.Now()
-		if item.PublishedParsed != nil {
-			published = *item.PublishedParsed
-		}
-		articles = append(articles, Article{
-			Title:       item.Title,
-			Url:         item.Link,
-			Source:      feed.Title,
-			PublishedAt: published,
-			Summary:     item.Description,
-		})
+	if len(clusters) > query.Limit {
+		clusters = clusters[:query.Limit]
+		resp.HasMore = true
 	}
-	log.Logger.Info("Fetched articles from RSS", zap.String("url", url), zap.Int("count", len(articles)))
-	return articles, nil
-}
-
-func containsIgnoreCase(s, substr string) bool {
-	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+	for _, cluster := range clusters {
+		resp.Articles = append(resp.Articles, mergeCluster(cluster))
+	}
+	return resp, nil
 }
 
-func hasAnyTag(articleTags, filterTags []string) bool {
-	if len(articleTags) == 0 || len(filterTags) == 0 {
-		return false
-	}
-	for _, t := range articleTags {
-		for _, f := range filterTags {
-			if t == f {
-				return true
-			}
+// userSources returns the user's data sources of registered types, and an error for each of the others
+func (s *articleService) userSources(user *models.User) ([]userSource, []SourceError) {
+	sourceErrors := []SourceError{}
+	var sources []userSource
+	for _, ds := range user.DataSources {
+		source, ok := LookupArticleSource(ds.Type)
+		if !ok {
+			sourceErrors = append(sourceErrors, SourceError{Type: ds.Type, Url: ds.Url, Error: ErrUnknownDataSourceType.Error()})
+			continue
 		}
+		sources = append(sources, userSource{ds: ds, source: source, key: feedCacheKey(source.Describe(), ds)})
 	}
-	return false
+	return sources, sourceErrors
 }
 
-type devtoArticle struct {
-	Title       string    `json:"title"`
-	URL         string    `json:"url"`
-	PublishedAt time.Time `json:"published_at"`
-	Description string    `json:"description"`
-	Tags        []string  `json:"tag_list"`
-}
-
-func fetchArticlesFromDevTo(ctx context.Context, tags []string, limit int) ([]Article, error) {
-	baseURL := "https://dev.to/api/articles?per_page=%d"
-	if len(tags) > 0 {
-		baseURL += "&tag=" + tags[0]
-	}
-	url := fmt.Sprintf(baseURL, limit)
-
-	req, _ := http.NewRequestWithContext(ctx, "GET", url, nil)
-	resp, err := http.DefaultClient.Do(req)
+// Ingest reads every distinct data source configured by the users into the articles collection
+func (s *articleService) Ingest(ctx context.Context) error {
+	configured, err := s.userRepository.ListDataSources(ctx)
 	if err != nil {
-		log.Logger.Error("Failed to fetch dev.to articles", zap.String("url", url), zap.Error(err))
-		return nil, err
+		return err
 	}
-	defer resp.Body.Close()
 
-	var devtoResp []devtoArticle
-	if err := json.NewDecoder(resp.Body).Decode(&devtoResp); err != nil {
-		log.Logger.Error("Failed to decode dev.to response", zap.String("url", url), zap.Error(err))
-		return nil, err
-	}
-	articles := make([]Article, 0, limit)
-	for i, item := range devtoResp {
-		if i >= limit {
-			break
+	seen := make(map[string]bool)
+	var sources []userSource
+	for _, ds := range configured {
+		source, ok := LookupArticleSource(ds.Type)
+		if !ok {
+			continue
 		}
-		articles = append(articles, Article{
-			Title:       item.Title,
-			Url:         item.URL,
-			Source:      "dev.to",
-			PublishedAt: item.PublishedAt,
-			Summary:     item.Description,
-			Tags:        item.Tags,
-		})
+		key := feedCacheKey(source.Describe(), ds)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		sources = append(sources, userSource{ds: ds, source: source, key: key})
 	}
-	log.Logger.Info("Fetched articles from dev.to", zap.String("url", url), zap.Int("count", len(articles)))
-	return articles, nil
-}
 
-type hackerNewsItem struct {
-	ID    int    `json:"id"`
-	Title string `json:"title"`
-	Url   string `json:"url"`
-	Time  int64  `json:"time"`
+	failed := 0
+	for i, err := range s.ingestAll(ctx, sources) {
+		if err != nil {
+			failed++
+			log.Logger.Warn("Failed to ingest data source",
+				zap.String("type", string(sources[i].ds.Type)),
+				zap.String("url", sources[i].ds.Url),
+				zap.Error(err),
+			)
+		}
+	}
+	log.Logger.Info("Data sources ingested", zap.Int("sources", len(sources)), zap.Int("failed", failed))
+	return s.clusterNew(ctx)
 }
 
-func fetchArticlesFromHackerNews(ctx context.Context, limit int) ([]Article, error) {
-	ctx, cancel := context.WithTimeout(ctx, 8*time.Second)
-	defer cancel()
-
-	req, _ := http.NewRequestWithContext(ctx, "GET", "https://hacker-news.firebaseio.com/v0/topstories.json", nil)
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		log.Logger.Error("Failed to fetch Hacker News top stories", zap.Error(err))
-		return nil, err
+// ingestNewSources reads sources that were never ingested, and clusters their articles, outside of the request
+// that found them. A source already being read by this instance is not read again.
+func (s *articleService) ingestNewSources(sources []userSource) {
+	var todo []userSource
+	for _, us := range sources {
+		if _, reading := s.newSources.LoadOrStore(us.key, true); !reading {
+			todo = append(todo, us)
+		}
 	}
-	defer resp.Body.Close()
-	var ids []int
-	if err := json.NewDecoder(resp.Body).Decode(&ids); err != nil {
-		log.Logger.Error("Failed to decode Hacker News IDs", zap.Error(err))
-		return nil, err
+	if len(todo) == 0 {
+		return
 	}
 
-	articles := make([]Article, 0, limit)
-	type result struct {
-		article Article
-		ok      bool
-	}
-	resultsCh := make(chan result, limit)
-	var wg sync.WaitGroup
-	maxConcurrent := 8 // Limite de goroutines simultâneas
-	sem := make(chan struct{}, maxConcurrent)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), newSourcesIngestTimeout)
+		defer cancel()
+		defer func() {
+			for _, us := range todo {
+				s.newSources.Delete(us.key)
+			}
+		}()
 
-	for i, id := range ids {
-		if i >= limit {
-			break
+		// Failures are recorded in the feed cache and reported by the next request
+		for i, err := range s.ingestAll(ctx, todo) {
+			if err != nil {
+				log.Logger.Warn("Failed to ingest new data source",
+					zap.String("type", string(todo[i].ds.Type)),
+					zap.String("url", todo[i].ds.Url),
+					zap.Error(err),
+				)
+			}
+		}
+		if err := s.clusterNew(ctx); err != nil {
+			log.Logger.Warn("Failed to cluster new articles", zap.Error(err))
 		}
+	}()
+}
+
+// ingestAll ingests the sources concurrently and returns their errors in the same order
+func (s *articleService) ingestAll(ctx context.Context, sources []userSource) []error {
+	errs := make([]error, len(sources))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentIngests)
+	for i, us := range sources {
 		wg.Add(1)
-		sem <- struct{}{} // Adquire slot
-		go func(id int) {
+		sem <- struct{}{}
+		go func(i int, us userSource) {
 			defer wg.Done()
-			defer func() { <-sem }() // Libera slot
-			itemUrl := fmt.Sprintf("https://hacker-news.firebaseio.com/v0/item/%d.json", id)
-			itemReq, _ := http.NewRequestWithContext(ctx, "GET", itemUrl, nil)
-			itemResp, err := http.DefaultClient.Do(itemReq)
-			if err != nil {
-				log.Logger.Warn("Failed to fetch Hacker News item", zap.String("itemUrl", itemUrl), zap.Error(err))
-				resultsCh <- result{ok: false}
-				return
-			}
-			var item hackerNewsItem
-			if err := json.NewDecoder(itemResp.Body).Decode(&item); err != nil {
-				itemResp.Body.Close()
-				log.Logger.Warn("Failed to decode Hacker News item", zap.String("itemUrl", itemUrl), zap.Error(err))
-				resultsCh <- result{ok: false}
-				return
-			}
-			itemResp.Body.Close()
-			if item.Url == "" {
-				resultsCh <- result{ok: false}
-				return
-			}
-			resultsCh <- result{article: Article{
-				Title:       item.Title,
-				Url:         item.Url,
-				Source:      "Hacker News",
-				PublishedAt: time.Unix(item.Time, 0),
-			}, ok: true}
-		}(id)
+			defer func() { <-sem }()
+			errs[i] = s.ingest(ctx, us)
+		}(i, us)
 	}
 	wg.Wait()
-	close(resultsCh)
-	for res := range resultsCh {
-		if res.ok {
-			articles = append(articles, res.article)
+	return errs
+}
+
+// ingest reads a data source through the feed cache and upserts its articles under their canonical URL.
+// Articles served from a stale cache entry are stored as well, but the upstream error is still returned.
+func (s *articleService) ingest(ctx context.Context, us userSource) error {
+	articles, fetchErr := s.feedCache.fetch(ctx, us.source, us.ds, articlesPerSource)
+
+	urls := make([]string, 0, len(articles))
+	for _, a := range articles {
+		if a.Url != "" {
+			urls = append(urls, a.Url)
+		}
+	}
+	canonical := s.canonicalURLs(ctx, urls)
+
+	now := time.Now().UTC()
+	docs := make([]models.IngestedArticle, 0, len(articles))
+	for _, a := range articles {
+		if a.Url == "" {
+			continue
 		}
+		docs = append(docs, models.IngestedArticle{
+			Article:      a,
+			SourceKey:    us.key,
+			SourceType:   us.ds.Type,
+			CanonicalURL: canonical[a.Url],
+			Language:     detectLanguage(a.Title + " " + a.Summary),
+			FirstSeenAt:  now,
+			FetchedAt:    now,
+		})
+	}
+	created, err := s.articleRepository.UpsertMany(ctx, docs)
+	if err != nil {
+		return err
+	}
+	if created > 0 {
+		log.Logger.Debug("New articles ingested", zap.String("sourceKey", us.key), zap.Int("count", created))
 	}
-	log.Logger.Info("Fetched articles from Hacker News (parallel)", zap.Int("count", len(articles)))
-	return articles, nil
+	return fetchErr
 }
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/postpilot/api/internal/models"
+)
+
+var ErrUnknownDataSourceType = errors.New("unknown data source type")
+
+// ArticleSourceType describes a data source type and the configuration it takes
+type ArticleSourceType struct {
+	Type         models.DataSourceType `json:"type" example:"rss"`
+	Name         string                `json:"name" example:"RSS / Atom"`
+	Description  string                `json:"description"`
+	URLRequired  bool                  `json:"urlRequired"`
+	SupportsTags bool                  `json:"supportsTags"`
+	// Options are the other data source fields the type reads, like "listing" or "minScore"
+	Options []string `json:"options,omitempty"`
+}
+
+// ArticleSource fetches articles from one type of data source. Implementations register themselves
+// with RegisterArticleSource, which makes the type valid in the user's data sources and lists it in
+// GET /sources/types.
+type ArticleSource interface {
+	Describe() ArticleSourceType
+	// Validate checks the configuration of a data source of this type before it is saved
+	Validate(ds models.DataSource) error
+	Fetch(ctx context.Context, ds models.DataSource, limit int) ([]models.Article, error)
+}
+
+var (
+	articleSourcesMu sync.RWMutex
+	articleSources   = map[models.DataSourceType]ArticleSource{}
+)
+
+// RegisterArticleSource makes a source type available. It panics if the type is already registered.
+func RegisterArticleSource(source ArticleSource) {
+	articleSourcesMu.Lock()
+	defer articleSourcesMu.Unlock()
+
+	t := source.Describe().Type
+	if _, ok := articleSources[t]; ok {
+		panic(fmt.Sprintf("article source %q registered twice", t))
+	}
+	articleSources[t] = source
+}
+
+// LookupArticleSource returns the source registered for a type
+func LookupArticleSource(t models.DataSourceType) (ArticleSource, bool) {
+	articleSourcesMu.RLock()
+	defer articleSourcesMu.RUnlock()
+	source, ok := articleSources[t]
+	return source, ok
+}
+
+// ArticleSourceTypes lists the registered source types, sorted by type
+func ArticleSourceTypes() []ArticleSourceType {
+	articleSourcesMu.RLock()
+	defer articleSourcesMu.RUnlock()
+
+	types := make([]ArticleSourceType, 0, len(articleSources))
+	for _, source := range articleSources {
+		types = append(types, source.Describe())
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i].Type < types[j].Type })
+	return types
+}
+
+// ValidateDataSource checks that a data source has a registered type and a valid configuration for it
+func ValidateDataSource(ds models.DataSource) error {
+	source, ok := LookupArticleSource(ds.Type)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownDataSourceType, ds.Type)
+	}
+	if err := source.Validate(ds); err != nil {
+		return fmt.Errorf("%s data source: %w", ds.Type, err)
+	}
+	return nil
+}
+
+// dataSourceOption returns the value of an option of a data source as text, empty when unset
+func dataSourceOption(ds models.DataSource, name string) string {
+	switch name {
+	case "listing":
+		return ds.Listing
+	case "window":
+		return ds.Window
+	case "minScore":
+		if ds.MinScore > 0 {
+			return strconv.Itoa(ds.MinScore)
+		}
+	case "skipSelfPosts":
+		if ds.SkipSelfPosts {
+			return "true"
+		}
+	}
+	return ""
+}
+
+// validateSourceURL checks an optional data source URL and, when hosts are given, that it points to one of them
+func validateSourceURL(raw string, required bool, hosts ...string) error {
+	if raw == "" {
+		if required {
+			return errors.New("url is required")
+		}
+		return nil
+	}
+	if !isHTTPURL(raw) {
+		return errors.New("url must be an http(s) URL")
+	}
+	if len(hosts) == 0 {
+		return nil
+	}
+	u, _ := url.Parse(raw)
+	for _, host := range hosts {
+		if u.Hostname() == host {
+			return nil
+		}
+	}
+	return fmt.Errorf("url must point to %s", hosts[0])
+}
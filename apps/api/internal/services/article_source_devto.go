@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+func init() {
+	RegisterArticleSource(devToSource{})
+}
+
+// devToSource reads the latest dev.to articles, optionally of a single tag
+type devToSource struct{}
+
+type devtoArticle struct {
+	Title       string    `json:"title"`
+	URL         string    `json:"url"`
+	PublishedAt time.Time `json:"published_at"`
+	Description string    `json:"description"`
+	Tags        []string  `json:"tag_list"`
+}
+
+func (devToSource) Describe() ArticleSourceType {
+	return ArticleSourceType{
+		Type:         models.DataSourceDevTo,
+		Name:         "dev.to",
+		Description:  "Latest dev.to articles; only the first tag is used as a filter",
+		SupportsTags: true,
+	}
+}
+
+func (devToSource) Validate(ds models.DataSource) error {
+	return validateSourceURL(ds.Url, false, "dev.to")
+}
+
+func (d devToSource) Fetch(ctx context.Context, ds models.DataSource, limit int) ([]models.Article, error) {
+	articles, _, err := d.FetchConditional(ctx, ds, limit, FeedValidators{})
+	return articles, err
+}
+
+func (devToSource) FetchConditional(ctx context.Context, ds models.DataSource, limit int, validators FeedValidators) ([]models.Article, FeedValidators, error) {
+	endpoint := fmt.Sprintf("https://dev.to/api/articles?per_page=%d", limit)
+	if len(ds.Tags) > 0 {
+		endpoint += "&tag=" + url.QueryEscape(ds.Tags[0])
+	}
+
+	resp, validators, err := conditionalGet(ctx, endpoint, validators)
+	if err != nil {
+		if !errors.Is(err, ErrFeedNotModified) {
+			log.Logger.Error("Failed to fetch dev.to articles", zap.String("url", endpoint), zap.Error(err))
+		}
+		return nil, validators, err
+	}
+	defer resp.Body.Close()
+
+	var devtoResp []devtoArticle
+	if err := json.NewDecoder(resp.Body).Decode(&devtoResp); err != nil {
+		log.Logger.Error("Failed to decode dev.to response", zap.String("url", endpoint), zap.Error(err))
+		return nil, validators, err
+	}
+	articles := make([]models.Article, 0, limit)
+	for i, item := range devtoResp {
+		if i >= limit {
+			break
+		}
+		articles = append(articles, models.Article{
+			Title:       item.Title,
+			Url:         item.URL,
+			Source:      "dev.to",
+			PublishedAt: item.PublishedAt,
+			Summary:     item.Description,
+			Tags:        item.Tags,
+		})
+	}
+	log.Logger.Info("Fetched articles from dev.to", zap.String("url", endpoint), zap.Int("count", len(articles)))
+	return articles, validators, nil
+}
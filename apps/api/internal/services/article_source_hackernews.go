@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+func init() {
+	RegisterArticleSource(hackerNewsSource{})
+}
+
+// hackerNewsSource reads the Hacker News top stories that link to an article
+type hackerNewsSource struct{}
+
+type hackerNewsItem struct {
+	ID    int    `json:"id"`
+	Title string `json:"title"`
+	Url   string `json:"url"`
+	Time  int64  `json:"time"`
+}
+
+func (hackerNewsSource) Describe() ArticleSourceType {
+	return ArticleSourceType{
+		Type:        models.DataSourceHackerNews,
+		Name:        "Hacker News",
+		Description: "Hacker News top stories that link to an article",
+	}
+}
+
+func (hackerNewsSource) Validate(ds models.DataSource) error {
+	return validateSourceURL(ds.Url, false)
+}
+
+func (hackerNewsSource) Fetch(ctx context.Context, _ models.DataSource, limit int) ([]models.Article, error) {
+	ctx, cancel := context.WithTimeout(ctx, 8*time.Second)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, "GET", "https://hacker-news.firebaseio.com/v0/topstories.json", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		log.Logger.Error("Failed to fetch Hacker News top stories", zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var ids []int
+	if err := json.NewDecoder(resp.Body).Decode(&ids); err != nil {
+		log.Logger.Error("Failed to decode Hacker News IDs", zap.Error(err))
+		return nil, err
+	}
+
+	articles := make([]models.Article, 0, limit)
+	type result struct {
+		article models.Article
+		ok      bool
+	}
+	resultsCh := make(chan result, limit)
+	var wg sync.WaitGroup
+	maxConcurrent := 8 // Limite de goroutines simultâneas
+	sem := make(chan struct{}, maxConcurrent)
+
+	for i, id := range ids {
+		if i >= limit {
+			break
+		}
+		wg.Add(1)
+		sem <- struct{}{} // Adquire slot
+		go func(id int) {
+			defer wg.Done()
+			defer func() { <-sem }() // Libera slot
+			itemUrl := fmt.Sprintf("https://hacker-news.firebaseio.com/v0/item/%d.json", id)
+			itemReq, _ := http.NewRequestWithContext(ctx, "GET", itemUrl, nil)
+			itemResp, err := http.DefaultClient.Do(itemReq)
+			if err != nil {
+				log.Logger.Warn("Failed to fetch Hacker News item", zap.String("itemUrl", itemUrl), zap.Error(err))
+				resultsCh <- result{ok: false}
+				return
+			}
+			var item hackerNewsItem
+			if err := json.NewDecoder(itemResp.Body).Decode(&item); err != nil {
+				itemResp.Body.Close()
+				log.Logger.Warn("Failed to decode Hacker News item", zap.String("itemUrl", itemUrl), zap.Error(err))
+				resultsCh <- result{ok: false}
+				return
+			}
+			itemResp.Body.Close()
+			if item.Url == "" {
+				resultsCh <- result{ok: false}
+				return
+			}
+			resultsCh <- result{article: models.Article{
+				Title:       item.Title,
+				Url:         item.Url,
+				Source:      "Hacker News",
+				PublishedAt: time.Unix(item.Time, 0),
+			}, ok: true}
+		}(id)
+	}
+	wg.Wait()
+	close(resultsCh)
+	for res := range resultsCh {
+		if res.ok {
+			articles = append(articles, res.article)
+		}
+	}
+	log.Logger.Info("Fetched articles from Hacker News (parallel)", zap.Int("count", len(articles)))
+	return articles, nil
+}
@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/mmcdole/gofeed"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+const (
+	redditListingHot = "hot"
+	redditListingTop = "top"
+	redditListingNew = "new"
+
+	// redditPageSize is the largest page of a listing; posts below the minimum score are dropped from it
+	redditPageSize = 100
+	// redditSummaryLength bounds the summary taken from the text of a self-post
+	redditSummaryLength = 300
+)
+
+var (
+	// redditSubredditPath matches the path of a subreddit URL, like /r/golang
+	redditSubredditPath = regexp.MustCompile(`^/r/([A-Za-z0-9_]{2,21})/?$`)
+	// redditTextLink matches the links in the markdown of a self-post
+	redditTextLink = regexp.MustCompile(`https?://[^\s\)\]>"]+`)
+)
+
+func init() {
+	RegisterArticleSource(redditSource{})
+}
+
+// redditSource reads a listing of a subreddit from its JSON endpoint, or from its RSS feed when the
+// JSON one is refused. Link posts are read as the article they link to.
+type redditSource struct{}
+
+type redditListing struct {
+	Data struct {
+		Children []struct {
+			Data redditPost `json:"data"`
+		} `json:"children"`
+	} `json:"data"`
+}
+
+type redditPost struct {
+	Title      string  `json:"title"`
+	Url        string  `json:"url"`
+	Permalink  string  `json:"permalink"`
+	IsSelf     bool    `json:"is_self"`
+	SelfText   string  `json:"selftext"`
+	Score      int     `json:"score"`
+	CreatedUTC float64 `json:"created_utc"`
+	Flair      string  `json:"link_flair_text"`
+	Stickied   bool    `json:"stickied"`
+	Subreddit  string  `json:"subreddit"`
+}
+
+func (redditSource) Describe() ArticleSourceType {
+	return ArticleSourceType{
+		Type:        models.DataSourceReddit,
+		Name:        "Reddit",
+		Description: "Posts of a subreddit (url like https://www.reddit.com/r/golang). listing is hot (default), top or new; window (hour, day, week, month, year or all) applies to top; posts below minScore are skipped, and so are self-posts without an external link when skipSelfPosts is set. The flair of a post becomes a tag.",
+		URLRequired: true,
+		Options:     []string{"listing", "window", "minScore", "skipSelfPosts"},
+	}
+}
+
+func (redditSource) Validate(ds models.DataSource) error {
+	if err := validateSourceURL(ds.Url, true, "www.reddit.com", "reddit.com", "old.reddit.com"); err != nil {
+		return err
+	}
+	if _, err := subredditName(ds.Url); err != nil {
+		return err
+	}
+	switch ds.Listing {
+	case "", redditListingHot, redditListingTop, redditListingNew:
+	default:
+		return errors.New("listing must be one of: hot, top, new")
+	}
+	switch ds.Window {
+	case "", "hour", "day", "week", "month", "year", "all":
+	default:
+		return errors.New("window must be one of: hour, day, week, month, year, all")
+	}
+	if ds.Window != "" && ds.Listing != redditListingTop {
+		return errors.New("window only applies to the top listing")
+	}
+	if ds.MinScore < 0 {
+		return errors.New("minScore must not be negative")
+	}
+	return nil
+}
+
+func (r redditSource) Fetch(ctx context.Context, ds models.DataSource, limit int) ([]models.Article, error) {
+	articles, _, err := r.FetchConditional(ctx, ds, limit, FeedValidators{})
+	return articles, err
+}
+
+// FetchConditional reads the JSON listing and falls back to the RSS feed when it fails, as Reddit often
+// refuses JSON requests without OAuth. The feed has no scores, so there is no fallback with a minimum score.
+func (r redditSource) FetchConditional(ctx context.Context, ds models.DataSource, limit int, validators FeedValidators) ([]models.Article, FeedValidators, error) {
+	subreddit, err := subredditName(ds.Url)
+	if err != nil {
+		return nil, validators, err
+	}
+	articles, newValidators, err := r.fetchJSON(ctx, subreddit, ds, limit, validators)
+	if err == nil || errors.Is(err, ErrFeedNotModified) || ds.MinScore > 0 {
+		return articles, newValidators, err
+	}
+	articles, err = r.fetchRSS(ctx, subreddit, ds, limit)
+	return articles, FeedValidators{}, err
+}
+
+func (redditSource) fetchJSON(ctx context.Context, subreddit string, ds models.DataSource, limit int, validators FeedValidators) ([]models.Article, FeedValidators, error) {
+	endpoint := redditListingURL(subreddit, ds, ".json") + "&raw_json=1"
+	resp, validators, err := conditionalGet(ctx, endpoint, validators)
+	if err != nil {
+		if !errors.Is(err, ErrFeedNotModified) {
+			log.Logger.Warn("Failed to fetch subreddit listing", zap.String("url", endpoint), zap.Error(err))
+		}
+		return nil, validators, err
+	}
+	defer resp.Body.Close()
+
+	var listing redditListing
+	if err := json.NewDecoder(resp.Body).Decode(&listing); err != nil {
+		log.Logger.Warn("Failed to decode subreddit listing", zap.String("url", endpoint), zap.Error(err))
+		return nil, validators, err
+	}
+	articles := make([]models.Article, 0, limit)
+	for _, child := range listing.Data.Children {
+		if len(articles) >= limit {
+			break
+		}
+		post := child.Data
+		if post.Stickied || post.Score < ds.MinScore {
+			continue
+		}
+		article, ok := redditArticle(post, ds.SkipSelfPosts)
+		if ok {
+			articles = append(articles, article)
+		}
+	}
+	log.Logger.Info("Fetched articles from Reddit", zap.String("url", endpoint), zap.Int("count", len(articles)))
+	return articles, validators, nil
+}
+
+func (redditSource) fetchRSS(ctx context.Context, subreddit string, ds models.DataSource, limit int) ([]models.Article, error) {
+	endpoint := redditListingURL(subreddit, ds, "/.rss")
+	resp, _, err := conditionalGet(ctx, endpoint, FeedValidators{})
+	if err != nil {
+		log.Logger.Error("Failed to fetch subreddit feed", zap.String("url", endpoint), zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	feed, err := gofeed.NewParser().Parse(resp.Body)
+	if err != nil {
+		log.Logger.Error("Failed to parse subreddit feed", zap.String("url", endpoint), zap.Error(err))
+		return nil, err
+	}
+	articles := make([]models.Article, 0, limit)
+	for _, item := range feed.Items {
+		if len(articles) >= limit {
+			break
+		}
+		// The content of an entry has the text of a self-post in a .md block and ends with a "[link]" to
+		// the URL of the post, its permalink for self-posts
+		post := redditPost{Title: item.Title, Permalink: item.Link, Subreddit: subreddit}
+		if item.PublishedParsed != nil {
+			post.CreatedUTC = float64(item.PublishedParsed.Unix())
+		} else if item.UpdatedParsed != nil {
+			post.CreatedUTC = float64(item.UpdatedParsed.Unix())
+		}
+		if doc, err := goquery.NewDocumentFromReader(strings.NewReader(item.Content)); err == nil {
+			text := []string{strings.TrimSpace(doc.Find(".md p").First().Text())}
+			doc.Find(".md a[href]").Each(func(_ int, a *goquery.Selection) {
+				text = append(text, a.AttrOr("href", ""))
+			})
+			post.SelfText = strings.Join(text, "\n\n")
+			doc.Find("a").EachWithBreak(func(_ int, a *goquery.Selection) bool {
+				if strings.TrimSpace(a.Text()) != "[link]" {
+					return true
+				}
+				post.Url = a.AttrOr("href", "")
+				return false
+			})
+		}
+		article, ok := redditArticle(post, ds.SkipSelfPosts)
+		if ok {
+			articles = append(articles, article)
+		}
+	}
+	log.Logger.Info("Fetched articles from subreddit feed", zap.String("url", endpoint), zap.Int("count", len(articles)))
+	return articles, nil
+}
+
+// redditArticle turns a post into an article: a link post is its link, and a self-post is the first external
+// link of its text or, unless skipSelfPosts is set, the post itself. Links to Reddit itself, like images
+// and crossposts, count as self-posts.
+func redditArticle(post redditPost, skipSelfPosts bool) (models.Article, bool) {
+	permalink := post.Permalink
+	if strings.HasPrefix(permalink, "/") {
+		permalink = "https://www.reddit.com" + permalink
+	}
+	article := models.Article{
+		Title:  post.Title,
+		Url:    post.Url,
+		Source: "r/" + post.Subreddit,
+	}
+	if post.CreatedUTC > 0 {
+		article.PublishedAt = time.Unix(int64(post.CreatedUTC), 0)
+	}
+	if post.IsSelf || post.Url == "" || isRedditURL(post.Url) {
+		article.Url = ""
+		for _, link := range redditTextLink.FindAllString(post.SelfText, -1) {
+			link = strings.TrimRight(link, ".,;:!?")
+			if !isRedditURL(link) {
+				article.Url = link
+				break
+			}
+		}
+		if article.Url == "" {
+			if skipSelfPosts {
+				return models.Article{}, false
+			}
+			article.Url = permalink
+		}
+		article.Summary = redditSummary(post.SelfText)
+	}
+	if flair := strings.ToLower(strings.TrimSpace(post.Flair)); flair != "" {
+		article.Tags = []string{flair}
+	}
+	return article, true
+}
+
+// redditSummary returns the first paragraph of the text of a self-post, shortened
+func redditSummary(text string) string {
+	paragraph, _, _ := strings.Cut(text, "\n\n")
+	runes := []rune(strings.TrimSpace(paragraph))
+	if len(runes) > redditSummaryLength {
+		return string(runes[:redditSummaryLength]) + "..."
+	}
+	return string(runes)
+}
+
+// redditListingURL returns the address of the listing of a subreddit with the given suffix, like ".json"
+func redditListingURL(subreddit string, ds models.DataSource, suffix string) string {
+	listing := ds.Listing
+	if listing == "" {
+		listing = redditListingHot
+	}
+	query := url.Values{}
+	query.Set("limit", fmt.Sprint(redditPageSize))
+	if listing == redditListingTop && ds.Window != "" {
+		query.Set("t", ds.Window)
+	}
+	return fmt.Sprintf("https://www.reddit.com/r/%s/%s%s?%s", subreddit, listing, suffix, query.Encode())
+}
+
+// subredditName returns the name of the subreddit of a URL like https://www.reddit.com/r/golang
+func subredditName(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", err
+	}
+	match := redditSubredditPath.FindStringSubmatch(u.Path)
+	if match == nil {
+		return "", errors.New("url must be a subreddit, like https://www.reddit.com/r/golang")
+	}
+	return match[1], nil
+}
+
+func isRedditURL(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	return host == "reddit.com" || strings.HasSuffix(host, ".reddit.com") || host == "redd.it" || strings.HasSuffix(host, ".redd.it")
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/mmcdole/gofeed"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+func init() {
+	RegisterArticleSource(rssSource{})
+}
+
+// rssSource reads the items of an RSS or Atom feed
+type rssSource struct{}
+
+func (rssSource) Describe() ArticleSourceType {
+	return ArticleSourceType{
+		Type:        models.DataSourceRSS,
+		Name:        "RSS / Atom",
+		Description: "Latest items of an RSS or Atom feed",
+		URLRequired: true,
+	}
+}
+
+func (rssSource) Validate(ds models.DataSource) error {
+	return validateSourceURL(ds.Url, true)
+}
+
+func (r rssSource) Fetch(ctx context.Context, ds models.DataSource, limit int) ([]models.Article, error) {
+	articles, _, err := r.FetchConditional(ctx, ds, limit, FeedValidators{})
+	return articles, err
+}
+
+func (rssSource) FetchConditional(ctx context.Context, ds models.DataSource, limit int, validators FeedValidators) ([]models.Article, FeedValidators, error) {
+	resp, validators, err := conditionalGet(ctx, ds.Url, validators)
+	if err != nil {
+		if !errors.Is(err, ErrFeedNotModified) {
+			log.Logger.Error("Failed to fetch RSS feed", zap.String("url", ds.Url), zap.Error(err))
+		}
+		return nil, validators, err
+	}
+	defer resp.Body.Close()
+
+	feed, err := gofeed.NewParser().Parse(resp.Body)
+	if err != nil {
+		log.Logger.Error("Failed to parse RSS feed", zap.String("url", ds.Url), zap.Error(err))
+		return nil, validators, err
+	}
+	articles := make([]models.Article, 0, limit)
+	for i, item := range feed.Items {
+		if i >= limit {
+			break
+		}
+		var published time.Time
+		if item.PublishedParsed != nil {
+			published = *item.PublishedParsed
+		}
+		articles = append(articles, models.Article{
+			Title:       item.Title,
+			Url:         item.Link,
+			Source:      feed.Title,
+			PublishedAt: published,
+			Summary:     item.Description,
+		})
+	}
+	log.Logger.Info("Fetched articles from RSS", zap.String("url", ds.Url), zap.Int("count", len(articles)))
+	return articles, validators, nil
+}
@@ -33,6 +33,8 @@ type AuthService interface {
 	GetUserByID(ctx context.Context, id string) (*models.User, error)
 	UpdateUser(ctx context.Context, user *models.User) error
 	ClearLinkedInToken(ctx context.Context, userID string) error
+	ClearDevToApiKey(ctx context.Context, userID primitive.ObjectID) error
+	ConnectLinkedIn(ctx context.Context, userID primitive.ObjectID, personUrn, accessToken, refreshToken string, expiresAt, refreshExpiresAt *time.Time) error
 }
 
 type authService struct {
@@ -235,3 +237,12 @@ func (s *authService) ClearLinkedInToken(ctx context.Context, userID string) err
 	}
 	return s.repo.ClearLinkedInToken(ctx, objID)
 }
+
+func (s *authService) ClearDevToApiKey(ctx context.Context, userID primitive.ObjectID) error {
+	return s.repo.ClearDevToApiKey(ctx, userID)
+}
+
+// ConnectLinkedIn saves the publishing token of a new LinkedIn authorization, replacing the previous one entirely
+func (s *authService) ConnectLinkedIn(ctx context.Context, userID primitive.ObjectID, personUrn, accessToken, refreshToken string, expiresAt, refreshExpiresAt *time.Time) error {
+	return s.repo.ConnectLinkedIn(ctx, userID, personUrn, accessToken, refreshToken, expiresAt, refreshExpiresAt)
+}
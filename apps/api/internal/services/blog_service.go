@@ -0,0 +1,369 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/httpclient"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrBlogConnectionNotFound  = errors.New("blog connection not found")
+	ErrBlogCredentialsRequired = errors.New("WordPress needs a username and an application password, Ghost needs an Admin API key")
+	ErrBlogVerificationFailed  = errors.New("could not connect to the blog")
+	ErrBlogUnauthorized        = errors.New("blog credentials were rejected")
+	ErrInvalidBlogURL          = errors.New("invalid blog url")
+)
+
+// BlogArticle is an article sent to a blog, with its content already in HTML
+type BlogArticle struct {
+	Title           string
+	HTML            string
+	Tags            []string
+	FeatureImageURL string
+	Published       bool
+}
+
+// BlogPostResult is a post created on a blog, along with the raw response
+type BlogPostResult struct {
+	ID     string
+	URL    string
+	Status string
+	Raw    map[string]interface{}
+}
+
+// BlogPublisher publishes articles to one blog platform with the credentials of a connection
+type BlogPublisher interface {
+	// Verify checks that the connection's credentials are accepted and returns the site name, when known
+	Verify(ctx context.Context, conn *models.BlogConnection) (string, error)
+	Publish(ctx context.Context, conn *models.BlogConnection, article BlogArticle) (*BlogPostResult, error)
+}
+
+// BlogConnectionInput holds the site and credentials of a blog. Only the credentials of its platform are used.
+type BlogConnectionInput struct {
+	Platform            models.BlogPlatform
+	SiteURL             string
+	Username            string
+	ApplicationPassword string
+	AdminAPIKey         string
+}
+
+// BlogPostInput holds an article to publish. Without Content, the output of the generated post PostLogID is used.
+// Content is plain text split into paragraphs, unless ContentIsHTML is set. With the approval workflow PostLogID is
+// required and Content, when given, must be its approved text.
+type BlogPostInput struct {
+	PostLogID       primitive.ObjectID
+	Title           string
+	Content         string
+	ContentIsHTML   bool
+	Tags            []string
+	FeatureImageURL string
+	Published       bool
+}
+
+type BlogService interface {
+	Connect(ctx context.Context, userID primitive.ObjectID, input BlogConnectionInput) (*models.BlogConnection, error)
+	UpdateConnection(ctx context.Context, userID, connectionID primitive.ObjectID, input BlogConnectionInput) (*models.BlogConnection, error)
+	ListConnections(ctx context.Context, userID primitive.ObjectID) ([]models.BlogConnection, error)
+	DeleteConnection(ctx context.Context, userID, connectionID primitive.ObjectID) error
+	Publish(ctx context.Context, user *models.User, connectionID primitive.ObjectID, input BlogPostInput) (*models.SocialPostStories, error)
+}
+
+type blogService struct {
+	connectionRepository repositories.BlogConnectionRepository
+	logRepository        repositories.PostGenerationLogRepository
+	storiesRepository    repositories.SocialPostStoriesRepository
+	webhookService       WebhookService
+	publishers           map[models.BlogPlatform]BlogPublisher
+}
+
+func NewBlogService(
+	connectionRepo repositories.BlogConnectionRepository,
+	logRepo repositories.PostGenerationLogRepository,
+	storiesRepo repositories.SocialPostStoriesRepository,
+	webhookService WebhookService,
+	wordPressClient *WordPressClient,
+	ghostClient *GhostClient,
+) BlogService {
+	return &blogService{
+		connectionRepository: connectionRepo,
+		logRepository:        logRepo,
+		storiesRepository:    storiesRepo,
+		webhookService:       webhookService,
+		publishers: map[models.BlogPlatform]BlogPublisher{
+			models.BlogPlatformWordPress: wordPressClient,
+			models.BlogPlatformGhost:     ghostClient,
+		},
+	}
+}
+
+// Connect saves a blog after checking that its credentials are accepted by the site
+func (s *blogService) Connect(ctx context.Context, userID primitive.ObjectID, input BlogConnectionInput) (*models.BlogConnection, error) {
+	now := time.Now().UTC()
+	conn := &models.BlogConnection{
+		UserID:    userID,
+		Platform:  input.Platform,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.applyAndVerify(ctx, conn, input); err != nil {
+		return nil, err
+	}
+
+	id, err := s.connectionRepository.Create(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	conn.ID = id
+
+	log.Logger.Info("Blog connected",
+		zap.String("userId", userID.Hex()),
+		zap.String("connectionId", id.Hex()),
+		zap.String("platform", string(conn.Platform)),
+	)
+	return conn, nil
+}
+
+// UpdateConnection replaces the site and credentials of a blog, checking them again. The platform cannot change.
+func (s *blogService) UpdateConnection(ctx context.Context, userID, connectionID primitive.ObjectID, input BlogConnectionInput) (*models.BlogConnection, error) {
+	conn, err := s.getConnection(ctx, userID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyAndVerify(ctx, conn, input); err != nil {
+		return nil, err
+	}
+
+	set := bson.M{
+		"siteUrl":             conn.SiteURL,
+		"siteName":            conn.SiteName,
+		"username":            conn.Username,
+		"applicationPassword": conn.ApplicationPassword,
+		"adminApiKey":         conn.AdminAPIKey,
+		"verifiedAt":          conn.VerifiedAt,
+	}
+	updated, err := s.connectionRepository.Update(ctx, connectionID, set)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrBlogConnectionNotFound
+	}
+	return updated, nil
+}
+
+func (s *blogService) ListConnections(ctx context.Context, userID primitive.ObjectID) ([]models.BlogConnection, error) {
+	connections, err := s.connectionRepository.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if connections == nil {
+		connections = []models.BlogConnection{}
+	}
+	return connections, nil
+}
+
+func (s *blogService) DeleteConnection(ctx context.Context, userID, connectionID primitive.ObjectID) error {
+	if _, err := s.getConnection(ctx, userID, connectionID); err != nil {
+		return err
+	}
+	return s.connectionRepository.Delete(ctx, connectionID)
+}
+
+// Publish posts an article to a connected blog, as a draft unless Published is set, and records it as a story
+// whose network is the blog platform. Failed attempts are recorded too.
+func (s *blogService) Publish(ctx context.Context, user *models.User, connectionID primitive.ObjectID, input BlogPostInput) (*models.SocialPostStories, error) {
+	conn, err := s.getConnection(ctx, user.ID, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	// Connections saved before sites had to be https are refused rather than sent the credentials in clear
+	if err := validateBlogURL(conn.SiteURL); err != nil {
+		return nil, err
+	}
+	if user.RequiresApproval() && input.PostLogID == primitive.NilObjectID {
+		return nil, ErrPostNotApproved
+	}
+
+	content := input.Content
+	if input.PostLogID != primitive.NilObjectID {
+		postLog, err := s.logRepository.GetByID(ctx, input.PostLogID)
+		if err != nil {
+			return nil, err
+		}
+		if postLog == nil || postLog.UserID != user.ID {
+			return nil, ErrPostNotFound
+		}
+		if err := ensurePostApproved(user, postLog); err != nil {
+			return nil, err
+		}
+		if err := ensureApprovedText(user, postLog, content); err != nil {
+			return nil, err
+		}
+		if content == "" {
+			content = postLog.Output
+		}
+	}
+	if strings.TrimSpace(content) == "" {
+		return nil, ErrEmptyPostText
+	}
+
+	article := BlogArticle{
+		Title:           strings.TrimSpace(input.Title),
+		HTML:            content,
+		Tags:            input.Tags,
+		FeatureImageURL: input.FeatureImageURL,
+		Published:       input.Published,
+	}
+	if !input.ContentIsHTML {
+		article.HTML = textToHTML(content)
+	}
+
+	createdAt := time.Now().UTC()
+	story := &models.SocialPostStories{
+		UserID:              user.ID,
+		PostGenerationLogID: input.PostLogID,
+		Network:             string(conn.Platform),
+		PostContent:         content,
+		Payload: map[string]interface{}{
+			"connectionId":    conn.ID.Hex(),
+			"siteUrl":         conn.SiteURL,
+			"title":           article.Title,
+			"tags":            article.Tags,
+			"featureImageUrl": article.FeatureImageURL,
+			"published":       article.Published,
+		},
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+		Status:    models.StoryStatusStarted,
+	}
+
+	result, err := s.publishers[conn.Platform].Publish(ctx, conn, article)
+	story.UpdatedAt = time.Now().UTC()
+	if err != nil {
+		story.Status = models.StoryStatusError
+		story.Error = err.Error()
+		story.ID, _ = s.storiesRepository.Create(ctx, story)
+		emitStoryResult(ctx, s.webhookService, user.ID, story)
+		return nil, err
+	}
+
+	story.Status = models.StoryStatusSuccess
+	story.ExternalPostID = result.ID
+	story.ExternalURL = result.URL
+	story.Response = result.Raw
+	id, err := s.storiesRepository.Create(ctx, story)
+	if err != nil {
+		return nil, err
+	}
+	story.ID = id
+
+	log.Logger.Info("Blog post created",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("storyId", id.Hex()),
+		zap.String("platform", string(conn.Platform)),
+		zap.String("externalPostId", result.ID),
+		zap.String("status", result.Status),
+	)
+	if article.Published {
+		markReviewPublished(ctx, s.logRepository, input.PostLogID, user.ID)
+	}
+	emitStoryResult(ctx, s.webhookService, user.ID, story)
+	return story, nil
+}
+
+func (s *blogService) getConnection(ctx context.Context, userID, connectionID primitive.ObjectID) (*models.BlogConnection, error) {
+	conn, err := s.connectionRepository.GetByID(ctx, connectionID)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil || conn.UserID != userID {
+		return nil, ErrBlogConnectionNotFound
+	}
+	return conn, nil
+}
+
+// applyAndVerify sets the site and the credentials of its platform on conn and checks them against the site
+func (s *blogService) applyAndVerify(ctx context.Context, conn *models.BlogConnection, input BlogConnectionInput) error {
+	publisher, ok := s.publishers[conn.Platform]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnsupportedNetwork, conn.Platform)
+	}
+
+	conn.SiteURL = strings.TrimRight(input.SiteURL, "/")
+	if err := validateBlogURL(conn.SiteURL); err != nil {
+		return err
+	}
+	conn.Username, conn.ApplicationPassword, conn.AdminAPIKey = "", "", ""
+	switch conn.Platform {
+	case models.BlogPlatformWordPress:
+		if input.Username == "" || input.ApplicationPassword == "" {
+			return ErrBlogCredentialsRequired
+		}
+		conn.Username = input.Username
+		// WordPress shows application passwords in groups of four characters; the spaces are optional
+		conn.ApplicationPassword = strings.ReplaceAll(input.ApplicationPassword, " ", "")
+	case models.BlogPlatformGhost:
+		if input.AdminAPIKey == "" {
+			return ErrBlogCredentialsRequired
+		}
+		if _, err := ghostAdminToken(input.AdminAPIKey, time.Now()); err != nil {
+			return err
+		}
+		conn.AdminAPIKey = input.AdminAPIKey
+	}
+
+	siteName, err := publisher.Verify(ctx, conn)
+	if err != nil {
+		if errors.Is(err, ErrBlogUnauthorized) {
+			return err
+		}
+		return fmt.Errorf("%w: %v", ErrBlogVerificationFailed, err)
+	}
+	conn.SiteName = siteName
+	conn.VerifiedAt = time.Now().UTC()
+	return nil
+}
+
+// validateBlogURL checks that a blog is a public https site: connections carry credentials, and sites are given by
+// users, so they must not reach internal addresses
+func validateBlogURL(raw string) error {
+	if err := httpclient.ValidatePublicURL(raw); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidBlogURL, err)
+	}
+	if u, _ := url.Parse(raw); u.Scheme != "https" {
+		return fmt.Errorf("%w: the blog must use https", ErrInvalidBlogURL)
+	}
+	return nil
+}
+
+// textToHTML turns plain text into HTML paragraphs: blank lines separate paragraphs and single line breaks are kept
+func textToHTML(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	var b strings.Builder
+	for _, paragraph := range strings.Split(text, "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		lines := strings.Split(paragraph, "\n")
+		for i, line := range lines {
+			lines[i] = html.EscapeString(strings.TrimSpace(line))
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.Join(lines, "<br>"))
+		b.WriteString("</p>\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,254 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+const (
+	// calendarFeedLimit caps the scheduled drafts and the published posts listed in a feed
+	calendarFeedLimit = 500
+	// calendarEventDuration is how long each post is shown in the calendar
+	calendarEventDuration   = 30 * time.Minute
+	calendarSummaryMaxRunes = 120
+	icsTimeLayout           = "20060102T150405Z"
+)
+
+type CalendarService interface {
+	RotateToken(ctx context.Context, userID primitive.ObjectID) (string, error)
+	RevokeToken(ctx context.Context, userID primitive.ObjectID) error
+	Feed(ctx context.Context, token string) ([]byte, error)
+}
+
+type calendarService struct {
+	userRepository    repositories.UserRepository
+	draftRepository   repositories.DraftRepository
+	storiesRepository repositories.SocialPostStoriesRepository
+}
+
+func NewCalendarService(
+	userRepo repositories.UserRepository,
+	draftRepo repositories.DraftRepository,
+	storiesRepo repositories.SocialPostStoriesRepository,
+) CalendarService {
+	return &calendarService{
+		userRepository:    userRepo,
+		draftRepository:   draftRepo,
+		storiesRepository: storiesRepo,
+	}
+}
+
+// RotateToken creates a new calendar feed token for the user, revoking the previous one.
+// Only its hash is stored, so the token is returned once and cannot be shown again.
+func (s *calendarService) RotateToken(ctx context.Context, userID primitive.ObjectID) (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := "cal_" + hex.EncodeToString(b)
+
+	if err := s.userRepository.SetCalendarTokenHash(ctx, userID, hashCalendarToken(token)); err != nil {
+		return "", err
+	}
+	log.Logger.Info("Calendar feed token rotated", zap.String("userId", userID.Hex()))
+	return token, nil
+}
+
+func (s *calendarService) RevokeToken(ctx context.Context, userID primitive.ObjectID) error {
+	if err := s.userRepository.SetCalendarTokenHash(ctx, userID, ""); err != nil {
+		return err
+	}
+	log.Logger.Info("Calendar feed token revoked", zap.String("userId", userID.Hex()))
+	return nil
+}
+
+// Feed renders the iCalendar feed of the user the token belongs to: scheduled drafts as tentative events
+// and successfully published posts as confirmed events linking to the post on its network.
+func (s *calendarService) Feed(ctx context.Context, token string) ([]byte, error) {
+	if token == "" {
+		return nil, ErrCalendarFeedNotFound
+	}
+	user, err := s.userRepository.FindByCalendarTokenHash(ctx, hashCalendarToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrCalendarFeedNotFound
+	}
+
+	var events []calendarEvent
+	for _, status := range []models.DraftStatus{models.DraftStatusScheduled, models.DraftStatusPublishing} {
+		drafts, err := s.draftRepository.ListByUser(ctx, user.ID, status, calendarFeedLimit)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range drafts {
+			if d.ScheduledAt == nil {
+				continue
+			}
+			networks := make([]string, len(d.ScheduleTargets))
+			for i, t := range d.ScheduleTargets {
+				networks[i] = t.Network
+			}
+			events = append(events, calendarEvent{
+				UID:        "draft-" + d.ID.Hex() + "@postpilot",
+				Start:      *d.ScheduledAt,
+				Stamp:      d.UpdatedAt,
+				Text:       postText(d.Text, d.Parts),
+				Categories: networks,
+				Status:     "TENTATIVE",
+			})
+		}
+	}
+
+	stories, err := s.storiesRepository.ListByUser(ctx, user.ID, calendarFeedLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, story := range stories {
+		if story.Status != models.StoryStatusSuccess {
+			continue
+		}
+		events = append(events, calendarEvent{
+			UID:        "story-" + story.ID.Hex() + "@postpilot",
+			Start:      story.CreatedAt,
+			Stamp:      story.UpdatedAt,
+			Text:       postText(story.PostContent, story.Parts),
+			URL:        externalPostURL(&story),
+			Categories: []string{story.Network},
+			Status:     "CONFIRMED",
+		})
+	}
+
+	return renderCalendar("PostPilot - "+user.Name, events), nil
+}
+
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// postText is the text of a post, or the text of its first part when the post only has parts
+func postText(text string, parts []models.PostPart) string {
+	if text == "" && len(parts) > 0 {
+		return parts[0].Text
+	}
+	return text
+}
+
+// externalPostURL is the link to a published post on its network, when it was returned on publishing
+// or can be built from the stored post ID
+func externalPostURL(story *models.SocialPostStories) string {
+	if story.ExternalURL != "" {
+		return story.ExternalURL
+	}
+	if story.Network == models.NetworkLinkedIn && strings.HasPrefix(story.ExternalPostID, "urn:li:") {
+		return "https://www.linkedin.com/feed/update/" + story.ExternalPostID + "/"
+	}
+	return ""
+}
+
+type calendarEvent struct {
+	UID        string
+	Start      time.Time
+	Stamp      time.Time
+	Text       string
+	URL        string
+	Categories []string
+	Status     string
+}
+
+// renderCalendar writes events as an RFC 5545 calendar
+func renderCalendar(name string, events []calendarEvent) []byte {
+	var buf bytes.Buffer
+	writeICSLine(&buf, "BEGIN:VCALENDAR")
+	writeICSLine(&buf, "VERSION:2.0")
+	writeICSLine(&buf, "PRODID:-//PostPilot//Content Calendar//PT")
+	writeICSLine(&buf, "CALSCALE:GREGORIAN")
+	writeICSLine(&buf, "METHOD:PUBLISH")
+	writeICSLine(&buf, "X-WR-CALNAME:"+escapeICSText(name))
+	writeICSLine(&buf, "REFRESH-INTERVAL;VALUE=DURATION:PT1H")
+	writeICSLine(&buf, "X-PUBLISHED-TTL:PT1H")
+	for _, e := range events {
+		start := e.Start.UTC()
+		stamp := e.Stamp.UTC()
+		if stamp.IsZero() {
+			stamp = start
+		}
+		writeICSLine(&buf, "BEGIN:VEVENT")
+		writeICSLine(&buf, "UID:"+e.UID)
+		writeICSLine(&buf, "DTSTAMP:"+stamp.Format(icsTimeLayout))
+		writeICSLine(&buf, "DTSTART:"+start.Format(icsTimeLayout))
+		writeICSLine(&buf, "DTEND:"+start.Add(calendarEventDuration).Format(icsTimeLayout))
+		writeICSLine(&buf, "SUMMARY:"+escapeICSText(calendarSummary(e.Text)))
+		writeICSLine(&buf, "DESCRIPTION:"+escapeICSText(e.Text))
+		if e.URL != "" {
+			writeICSLine(&buf, "URL:"+e.URL)
+		}
+		if len(e.Categories) > 0 {
+			categories := make([]string, len(e.Categories))
+			for i, c := range e.Categories {
+				categories[i] = escapeICSText(c)
+			}
+			writeICSLine(&buf, "CATEGORIES:"+strings.Join(categories, ","))
+		}
+		writeICSLine(&buf, "STATUS:"+e.Status)
+		writeICSLine(&buf, "END:VEVENT")
+	}
+	writeICSLine(&buf, "END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// calendarSummary is the first non-empty line of a post, shortened to fit an event title
+func calendarSummary(text string) string {
+	summary := ""
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			summary = line
+			break
+		}
+	}
+	if utf8.RuneCountInString(summary) > calendarSummaryMaxRunes {
+		summary = string([]rune(summary)[:calendarSummaryMaxRunes-1]) + "…"
+	}
+	return summary
+}
+
+var icsTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+func escapeICSText(s string) string {
+	return icsTextEscaper.Replace(s)
+}
+
+// writeICSLine writes a content line folded at 75 octets, without splitting UTF-8 characters
+func writeICSLine(buf *bytes.Buffer, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts towards their length
+		limit = 74
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
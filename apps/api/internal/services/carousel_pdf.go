@@ -0,0 +1,245 @@
+package services
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/postpilot/api/internal/models"
+)
+
+// CarouselTheme holds the colors of carousel slides, as RGB components between 0 and 1
+type CarouselTheme struct {
+	Background [3]float64
+	Text       [3]float64
+	Accent     [3]float64
+}
+
+var carouselThemes = map[string]CarouselTheme{
+	"light": {Background: [3]float64{1, 1, 1}, Text: [3]float64{0.13, 0.13, 0.13}, Accent: [3]float64{0.04, 0.4, 0.76}},
+	"dark":  {Background: [3]float64{0.1, 0.11, 0.13}, Text: [3]float64{0.95, 0.95, 0.95}, Accent: [3]float64{0.98, 0.72, 0.15}},
+	"ocean": {Background: [3]float64{0.02, 0.2, 0.33}, Text: [3]float64{1, 1, 1}, Accent: [3]float64{0.3, 0.85, 0.8}},
+}
+
+// resolveCarouselTheme returns the named theme, falling back to the configured default and then to "light"
+func resolveCarouselTheme(name, fallback string) CarouselTheme {
+	if theme, ok := carouselThemes[name]; ok {
+		return theme
+	}
+	if theme, ok := carouselThemes[fallback]; ok {
+		return theme
+	}
+	return carouselThemes["light"]
+}
+
+// carouselTitle names the document after its first slide
+func carouselTitle(parts []models.PostPart) string {
+	title := []rune(parts[0].Title)
+	if len(title) == 0 {
+		title = []rune(parts[0].Text)
+	}
+	if len(title) > 100 {
+		title = append(title[:97], []rune("...")...)
+	}
+	return string(title)
+}
+
+// Slide geometry in PDF points, 4:5 like the portrait carousels LinkedIn shows best
+const (
+	slideWidth      = 540.0
+	slideHeight     = 675.0
+	slideMargin     = 48.0
+	slideTitleSize  = 30.0
+	slideBodySize   = 20.0
+	slideFooterSize = 12.0
+	// slideMinBodySize is as far as the body text is shrunk to fit a slide
+	slideMinBodySize = 12.0
+)
+
+// ErrSlideTextTooLong is returned for a part whose text does not fit on a slide even at the smallest body size
+var ErrSlideTextTooLong = errors.New("text does not fit on a carousel slide")
+
+// slideLayout is the text of a slide broken into lines, with the body size it fits at
+type slideLayout struct {
+	titleLines []string
+	bodyLines  []string
+	bodySize   float64
+	fits       bool
+}
+
+// layoutSlide wraps the title and text of part, shrinking the body from slideBodySize down to
+// slideMinBodySize until it fits above the footer
+func layoutSlide(part models.PostPart) slideLayout {
+	var layout slideLayout
+	top := slideHeight - slideMargin - slideTitleSize
+	if part.Title != "" {
+		layout.titleLines = wrapSlideText(part.Title, slideTitleSize)
+		top -= float64(len(layout.titleLines)) * slideTitleSize * 1.25
+	}
+
+	for size := slideBodySize; size >= slideMinBodySize; size-- {
+		var lines []string
+		for _, paragraph := range strings.Split(part.Text, "\n") {
+			lines = append(lines, wrapSlideText(paragraph, size)...)
+		}
+		y := top
+		if part.Title != "" {
+			y -= size
+		}
+		layout.bodyLines, layout.bodySize = lines, size
+		layout.fits = len(lines) == 0 || y-float64(len(lines)-1)*size*1.4 >= slideMargin+slideFooterSize*2
+		if layout.fits {
+			break
+		}
+	}
+	return layout
+}
+
+// validateCarouselParts checks that the text of every part fits on its slide
+func validateCarouselParts(parts []models.PostPart) error {
+	for i, part := range parts {
+		if !layoutSlide(part).fits {
+			return fmt.Errorf("%w: part %d is too long", ErrSlideTextTooLong, i+1)
+		}
+	}
+	return nil
+}
+
+// renderCarouselPDF renders one slide per part into a PDF document. It only uses the
+// standard Helvetica fonts, so no font has to be embedded.
+func renderCarouselPDF(parts []models.PostPart, theme CarouselTheme) []byte {
+	var objects []string
+	// 1: catalog, 2: page tree, 3: regular font, 4: bold font, then a page and its content per slide
+	objects = append(objects,
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"", // filled once the page object numbers are known
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	)
+
+	kids := make([]string, 0, len(parts))
+	for i, part := range parts {
+		pageNum := len(objects) + 1
+		contentNum := pageNum + 1
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageNum))
+
+		content := renderSlide(part, i+1, len(parts), theme)
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.0f %.0f] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				slideWidth, slideHeight, contentNum),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(parts))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// renderSlide builds the content stream of a single slide. Parts are validated with validateCarouselParts
+// before publishing; text that still overflows ends in an ellipsis on the last line that fits.
+func renderSlide(part models.PostPart, index, total int, theme CarouselTheme) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s rg 0 0 %.0f %.0f re f\n", pdfColor(theme.Background), slideWidth, slideHeight)
+	fmt.Fprintf(&b, "%s rg 0 %.0f %.0f 10 re f\n", pdfColor(theme.Accent), slideHeight-10, slideWidth)
+
+	layout := layoutSlide(part)
+	y := slideHeight - slideMargin - slideTitleSize
+	if part.Title != "" {
+		for _, line := range layout.titleLines {
+			writeSlideLine(&b, "F2", slideTitleSize, theme.Accent, y, line)
+			y -= slideTitleSize * 1.25
+		}
+		y -= layout.bodySize
+	}
+
+	for i, line := range layout.bodyLines {
+		if y-layout.bodySize*1.4 < slideMargin+slideFooterSize*2 && i < len(layout.bodyLines)-1 {
+			writeSlideLine(&b, "F1", layout.bodySize, theme.Text, y, line+" …")
+			break
+		}
+		writeSlideLine(&b, "F1", layout.bodySize, theme.Text, y, line)
+		y -= layout.bodySize * 1.4
+	}
+
+	footer := fmt.Sprintf("%d / %d", index, total)
+	fmt.Fprintf(&b, "BT /F1 %.0f Tf %s rg %.1f %.1f Td (%s) Tj ET\n",
+		slideFooterSize, pdfColor(theme.Text), slideWidth-slideMargin-float64(len(footer))*slideFooterSize*0.55, slideMargin/2, footer)
+	return b.String()
+}
+
+func writeSlideLine(b *strings.Builder, font string, size float64, color [3]float64, y float64, line string) {
+	fmt.Fprintf(b, "BT /%s %.0f Tf %s rg %.1f %.1f Td (%s) Tj ET\n", font, size, pdfColor(color), slideMargin, y, pdfString(line))
+}
+
+func pdfColor(c [3]float64) string {
+	return fmt.Sprintf("%.3f %.3f %.3f", c[0], c[1], c[2])
+}
+
+// wrapSlideText breaks text into lines that fit the slide width. Helvetica has no fixed
+// width, so the average glyph width is used, which errs on the side of shorter lines.
+func wrapSlideText(text string, size float64) []string {
+	maxChars := int((slideWidth - 2*slideMargin) / (size * 0.52))
+	var lines []string
+	current := ""
+	for _, word := range strings.Fields(text) {
+		switch {
+		case current == "":
+			current = word
+		case utf8.RuneCountInString(current)+1+utf8.RuneCountInString(word) <= maxChars:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+	return lines
+}
+
+// winAnsiExtras maps the characters of Windows-1252 that are not in Latin-1
+var winAnsiExtras = map[rune]byte{
+	'€': 0x80, '‚': 0x82, '„': 0x84, '…': 0x85, '‘': 0x91, '’': 0x92,
+	'“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '™': 0x99,
+}
+
+// pdfString encodes text as a WinAnsi PDF string literal body. Characters the
+// standard fonts cannot show are replaced with "?".
+func pdfString(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		var c byte
+		switch {
+		case r < 0x80 || (r >= 0xA0 && r <= 0xFF):
+			c = byte(r)
+		default:
+			if extra, ok := winAnsiExtras[r]; ok {
+				c = extra
+			} else {
+				c = '?'
+			}
+		}
+		if c == '(' || c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
@@ -0,0 +1,371 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/config"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+const (
+	replySuggestionsCount = 3
+	// commentReplyLease is how long a reply holds its comment; a claim left by a request that stopped is
+	// taken over after it
+	commentReplyLease = 5 * time.Minute
+	// commentsSyncLease bounds a run of the comments sync, which one instance at a time performs
+	commentsSyncLease = 10 * time.Minute
+)
+
+var (
+	ErrCommentNotFound        = errors.New("comment not found")
+	ErrCommentAlreadyReplied  = errors.New("comment has already been replied to")
+	ErrCommentReplyInProgress = errors.New("a reply to this comment is already being sent")
+	ErrInvalidCommentCursor   = errors.New("invalid cursor")
+)
+
+// CommentPage is a page of the comment inbox. NextCursor is empty on the last page.
+type CommentPage struct {
+	Comments   []models.PostComment `json:"comments"`
+	NextCursor string               `json:"nextCursor,omitempty"`
+}
+
+// commentCursor is the JSON inside an inbox cursor
+type commentCursor struct {
+	CommentedAt time.Time `json:"t"`
+	ID          string    `json:"i"`
+}
+
+type CommentService interface {
+	SyncComments(ctx context.Context) error
+	ListComments(ctx context.Context, userID primitive.ObjectID, status models.CommentStatus, cursor string, limit int) (*CommentPage, error)
+	UpdateStatus(ctx context.Context, userID, commentID primitive.ObjectID, status models.CommentStatus) (*models.PostComment, error)
+	SuggestReplies(ctx context.Context, user *models.User, commentID primitive.ObjectID) (*models.PostComment, error)
+	Reply(ctx context.Context, user *models.User, commentID primitive.ObjectID, text string) (*models.PostComment, error)
+}
+
+type commentService struct {
+	commentRepository repositories.PostCommentRepository
+	storiesRepository repositories.SocialPostStoriesRepository
+	userRepository    repositories.UserRepository
+	leaseRepository   repositories.JobLeaseRepository
+	linkedInClient    *LinkedInClient
+	openAIClient      *OpenAIClient
+	lookback          time.Duration
+}
+
+func NewCommentService(
+	commentRepo repositories.PostCommentRepository,
+	storiesRepo repositories.SocialPostStoriesRepository,
+	userRepo repositories.UserRepository,
+	leaseRepo repositories.JobLeaseRepository,
+	linkedInClient *LinkedInClient,
+	openAIClient *OpenAIClient,
+) CommentService {
+	return &commentService{
+		commentRepository: commentRepo,
+		storiesRepository: storiesRepo,
+		userRepository:    userRepo,
+		leaseRepository:   leaseRepo,
+		linkedInClient:    linkedInClient,
+		openAIClient:      openAIClient,
+		lookback:          config.Get().Jobs.CommentsSyncLookback,
+	}
+}
+
+// SyncComments pulls comments of recently published LinkedIn posts into the inbox, on one instance at a time
+func (s *commentService) SyncComments(ctx context.Context) error {
+	return withJobLease(ctx, s.leaseRepository, "comments-sync", commentsSyncLease, s.syncComments)
+}
+
+func (s *commentService) syncComments(ctx context.Context) error {
+	since := time.Now().UTC().Add(-s.lookback)
+	stories, err := s.storiesRepository.ListPublishedSince(ctx, models.NetworkLinkedIn, since)
+	if err != nil {
+		return err
+	}
+
+	users := make(map[primitive.ObjectID]*models.User)
+	skipped := make(map[primitive.ObjectID]bool)
+	newComments := 0
+
+	for _, story := range stories {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if skipped[story.UserID] {
+			continue
+		}
+
+		user, ok := users[story.UserID]
+		if !ok {
+			user, _ = s.userRepository.FindByID(ctx, story.UserID.Hex())
+			users[story.UserID] = user
+		}
+		if user == nil || user.LinkedinAccessToken == "" {
+			skipped[story.UserID] = true
+			continue
+		}
+
+		comments, err := s.linkedInClient.ListComments(ctx, user.LinkedinAccessToken, story.ExternalPostID)
+		if err != nil {
+			if errors.Is(err, ErrLinkedInUnauthorized) {
+				skipped[story.UserID] = true
+			}
+			log.Logger.Warn("Failed to pull post comments",
+				zap.String("storyId", story.ID.Hex()),
+				zap.String("externalPostId", story.ExternalPostID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		for _, c := range comments {
+			// The user's own comments, including replies sent from here, are not inbox items
+			if c.CommentURN == "" || c.Actor == user.LinkedinPersonUrn {
+				continue
+			}
+			inserted, err := s.commentRepository.Upsert(ctx, &models.PostComment{
+				UserID:            story.UserID,
+				StoryID:           story.ID,
+				Network:           story.Network,
+				ExternalPostID:    story.ExternalPostID,
+				ExternalCommentID: c.CommentURN,
+				AuthorURN:         c.Actor,
+				Text:              c.Text,
+				CommentedAt:       c.CreatedAt,
+			})
+			if err == nil && inserted {
+				newComments++
+			}
+		}
+	}
+
+	log.Logger.Info("Post comments sync completed",
+		zap.Int("posts", len(stories)),
+		zap.Int("newComments", newComments),
+	)
+	return nil
+}
+
+// ListComments returns a page of the user's comment inbox, newest first
+func (s *commentService) ListComments(ctx context.Context, userID primitive.ObjectID, status models.CommentStatus, cursor string, limit int) (*CommentPage, error) {
+	after, err := decodeCommentCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	// One extra comment tells whether there is a next page
+	comments, err := s.commentRepository.ListByUser(ctx, userID, status, after, limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &CommentPage{Comments: comments}
+	if len(comments) > limit {
+		page.Comments = comments[:limit]
+		last := page.Comments[limit-1]
+		data, _ := json.Marshal(commentCursor{CommentedAt: last.CommentedAt, ID: last.ID.Hex()})
+		page.NextCursor = base64.RawURLEncoding.EncodeToString(data)
+	}
+	if page.Comments == nil {
+		page.Comments = []models.PostComment{}
+	}
+	return page, nil
+}
+
+func decodeCommentCursor(raw string) (*repositories.CommentCursor, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, ErrInvalidCommentCursor
+	}
+	var c commentCursor
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, ErrInvalidCommentCursor
+	}
+	id, err := primitive.ObjectIDFromHex(c.ID)
+	if err != nil {
+		return nil, ErrInvalidCommentCursor
+	}
+	return &repositories.CommentCursor{CommentedAt: c.CommentedAt, ID: id}, nil
+}
+
+// UpdateStatus marks a comment as new or read
+func (s *commentService) UpdateStatus(ctx context.Context, userID, commentID primitive.ObjectID, status models.CommentStatus) (*models.PostComment, error) {
+	comment, err := s.getUserComment(ctx, userID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := s.commentRepository.UpdateStatus(ctx, commentID, status)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, commentReplyError(comment)
+	}
+	comment.Status = status
+	return comment, nil
+}
+
+// SuggestReplies generates reply suggestions for a comment with the user's configured model
+func (s *commentService) SuggestReplies(ctx context.Context, user *models.User, commentID primitive.ObjectID) (*models.PostComment, error) {
+	comment, err := s.getUserComment(ctx, user.ID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	story, err := s.storiesRepository.GetByID(ctx, comment.StoryID)
+	if err != nil {
+		return nil, err
+	}
+	postContent := ""
+	if story != nil {
+		postContent = story.PostContent
+	}
+
+	prompt := fmt.Sprintf(
+		"Você publicou o seguinte post no LinkedIn:\n\n%s\n\nUm leitor comentou:\n\n%s\n\n"+
+			"Sugira %d respostas curtas, cordiais e diferentes entre si para esse comentário, no mesmo idioma do comentário. "+
+			"Responda apenas com um array JSON de strings.",
+		postContent, comment.Text, replySuggestionsCount,
+	)
+
+	output, usedModel, _, err := s.openAIClient.GenerateText(ctx, user.OpenAiApiKey, userOpenAIModel(user), prompt)
+	if err != nil {
+		log.Logger.Error("Failed to generate reply suggestions",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("commentId", commentID.Hex()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	suggestions := parseReplySuggestions(output)
+	if err := s.commentRepository.SetReplySuggestions(ctx, commentID, suggestions); err != nil {
+		return nil, err
+	}
+
+	log.Logger.Info("Reply suggestions generated",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("commentId", commentID.Hex()),
+		zap.String("model", usedModel),
+		zap.Int("count", len(suggestions)),
+	)
+
+	comment.ReplySuggestions = suggestions
+	return comment, nil
+}
+
+// Reply posts an approved reply to a comment through the network API. The comment is claimed first, so that
+// concurrent requests for it send a single reply.
+func (s *commentService) Reply(ctx context.Context, user *models.User, commentID primitive.ObjectID, text string) (*models.PostComment, error) {
+	comment, err := s.getUserComment(ctx, user.ID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.Network != models.NetworkLinkedIn {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedNetwork, comment.Network)
+	}
+	if user.LinkedinAccessToken == "" || user.LinkedinPersonUrn == "" {
+		return nil, ErrLinkedInNotConnected
+	}
+
+	previous, err := s.commentRepository.ClaimReply(ctx, commentID, time.Now().UTC(), commentReplyLease)
+	if err != nil {
+		return nil, err
+	}
+	if previous == nil {
+		return nil, commentReplyError(comment)
+	}
+
+	replyURN, err := s.linkedInClient.CreateComment(ctx, user.LinkedinAccessToken, user.LinkedinPersonUrn, comment.ExternalPostID, comment.ExternalCommentID, text)
+	if err != nil {
+		log.Logger.Error("Failed to reply to comment",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("commentId", commentID.Hex()),
+			zap.Error(err),
+		)
+		status := previous.Status
+		if status == models.CommentStatusReplying {
+			status = models.CommentStatusRead
+		}
+		_ = s.commentRepository.ReleaseReply(context.WithoutCancel(ctx), commentID, status)
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	// The reply is on LinkedIn already: on failure the claim is kept, so it is not sent again before the lease ends
+	if err := s.commentRepository.MarkReplied(context.WithoutCancel(ctx), commentID, text, replyURN, now); err != nil {
+		return nil, err
+	}
+
+	log.Logger.Info("Comment replied",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("commentId", commentID.Hex()),
+		zap.String("replyUrn", replyURN),
+	)
+
+	comment.Status = models.CommentStatusReplied
+	comment.ReplyText = text
+	comment.ReplyExternalID = replyURN
+	comment.RepliedAt = &now
+	return comment, nil
+}
+
+// commentReplyError tells why a comment could not be claimed: it was replied to, or a reply is being sent
+func commentReplyError(comment *models.PostComment) error {
+	if comment.Status == models.CommentStatusReplied {
+		return ErrCommentAlreadyReplied
+	}
+	return ErrCommentReplyInProgress
+}
+
+func (s *commentService) getUserComment(ctx context.Context, userID, commentID primitive.ObjectID) (*models.PostComment, error) {
+	comment, err := s.commentRepository.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment == nil || comment.UserID != userID {
+		return nil, ErrCommentNotFound
+	}
+	return comment, nil
+}
+
+var listMarkerRegex = regexp.MustCompile(`^\s*(?:\d+[.)]|[-*•])\s*`)
+
+// parseReplySuggestions reads the model output as a JSON array, falling back to one suggestion per line,
+// and keeps at most replySuggestionsCount non-empty suggestions
+func parseReplySuggestions(output string) []string {
+	output = strings.TrimSpace(output)
+	output = strings.TrimPrefix(output, "```json")
+	output = strings.TrimSuffix(strings.TrimPrefix(output, "```"), "```")
+
+	var parsed []string
+	if err := json.Unmarshal([]byte(strings.TrimSpace(output)), &parsed); err != nil {
+		parsed = strings.Split(output, "\n")
+	}
+
+	suggestions := make([]string, 0, replySuggestionsCount)
+	for _, line := range parsed {
+		line = strings.Trim(listMarkerRegex.ReplaceAllString(line, ""), " \"")
+		if line != "" {
+			suggestions = append(suggestions, line)
+		}
+	}
+	if len(suggestions) > replySuggestionsCount {
+		suggestions = suggestions[:replySuggestionsCount]
+	}
+	return suggestions
+}
@@ -0,0 +1,111 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/postpilot/api/internal/httpclient"
+	"github.com/postpilot/api/internal/log"
+	"go.uber.org/zap"
+)
+
+const devToAPIBaseURL = "https://dev.to/api"
+
+var ErrDevToUnauthorized = errors.New("dev.to API key is invalid or was revoked")
+
+// DevToClient wraps calls to the dev.to (Forem) articles API, authenticated with each user's API key
+type DevToClient struct {
+	httpClient *http.Client
+	baseURL    string
+}
+
+// NewDevToClient creates a dev.to client using the shared HTTP client
+func NewDevToClient(client *httpclient.HTTPClient) *DevToClient {
+	return &DevToClient{
+		httpClient: client.Client(),
+		baseURL:    devToAPIBaseURL,
+	}
+}
+
+// DevToArticle is the article sent to dev.to. Unpublished articles are kept as drafts in the author's dashboard.
+type DevToArticle struct {
+	Title        string   `json:"title"`
+	BodyMarkdown string   `json:"body_markdown"`
+	Published    bool     `json:"published"`
+	Tags         []string `json:"tags"`
+	CanonicalURL string   `json:"canonical_url,omitempty"`
+}
+
+// DevToArticleResult is the article returned by dev.to, along with the raw response
+type DevToArticleResult struct {
+	ID        int64                  `json:"id"`
+	URL       string                 `json:"url"`
+	Published bool                   `json:"published"`
+	Raw       map[string]interface{} `json:"-"`
+}
+
+// CreateArticle creates an article on the account of the API key
+func (c *DevToClient) CreateArticle(ctx context.Context, apiKey string, article DevToArticle) (*DevToArticleResult, error) {
+	return c.sendArticle(ctx, http.MethodPost, "/articles", apiKey, article)
+}
+
+// UpdateArticle replaces the title, body, tags, canonical URL and published flag of an article
+func (c *DevToClient) UpdateArticle(ctx context.Context, apiKey string, id int64, article DevToArticle) (*DevToArticleResult, error) {
+	return c.sendArticle(ctx, http.MethodPut, "/articles/"+strconv.FormatInt(id, 10), apiKey, article)
+}
+
+func (c *DevToClient) sendArticle(ctx context.Context, method, path, apiKey string, article DevToArticle) (*DevToArticleResult, error) {
+	body, err := json.Marshal(map[string]interface{}{"article": article})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/vnd.forem.api-v1+json")
+	req.Header.Set("api-key", apiKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("dev.to request failed", zap.String("method", method), zap.String("path", path), zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		log.Logger.Warn("dev.to API key rejected", zap.String("path", path), zap.Int("statusCode", resp.StatusCode))
+		return nil, ErrDevToUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		log.Logger.Error("dev.to API error",
+			zap.String("method", method),
+			zap.String("path", path),
+			zap.Int("statusCode", resp.StatusCode),
+			zap.String("response", string(respBody)),
+		)
+		return nil, fmt.Errorf("dev.to API error (%d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result DevToArticleResult
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to decode dev.to response: %w", err)
+	}
+	if result.ID == 0 {
+		return nil, errors.New("dev.to returned no article ID")
+	}
+	_ = json.Unmarshal(respBody, &result.Raw)
+	return &result, nil
+}
@@ -0,0 +1,212 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrDevToNotConnected = errors.New("dev.to API key not configured for this user")
+	ErrStoryNotDevTo     = errors.New("story is not a dev.to article")
+)
+
+// DevToArticleInput holds the content of a dev.to article. Without Published the article is saved as a draft on dev.to.
+// PostLogID is the generated post the article comes from, required with the approval workflow.
+type DevToArticleInput struct {
+	PostLogID    primitive.ObjectID
+	Title        string
+	BodyMarkdown string
+	Tags         []string
+	CanonicalURL string
+	Published    bool
+}
+
+type DevToService interface {
+	PublishArticle(ctx context.Context, user *models.User, input DevToArticleInput) (*models.SocialPostStories, error)
+	UpdateArticle(ctx context.Context, user *models.User, storyID primitive.ObjectID, input DevToArticleInput) (*models.SocialPostStories, error)
+}
+
+type devToService struct {
+	devToClient       *DevToClient
+	logRepository     repositories.PostGenerationLogRepository
+	storiesRepository repositories.SocialPostStoriesRepository
+	webhookService    WebhookService
+}
+
+func NewDevToService(devToClient *DevToClient, logRepo repositories.PostGenerationLogRepository, storiesRepo repositories.SocialPostStoriesRepository, webhookService WebhookService) DevToService {
+	return &devToService{
+		devToClient:       devToClient,
+		logRepository:     logRepo,
+		storiesRepository: storiesRepo,
+		webhookService:    webhookService,
+	}
+}
+
+// PublishArticle creates an article on the user's dev.to account and records it as a story of the devto network.
+// Failed attempts are recorded too, like on the other networks. With the approval workflow the body must be the
+// text of an approved post.
+func (s *devToService) PublishArticle(ctx context.Context, user *models.User, input DevToArticleInput) (*models.SocialPostStories, error) {
+	if user.DevToApiKey == "" {
+		return nil, ErrDevToNotConnected
+	}
+	if err := ensureApprovedPost(ctx, s.logRepository, user, input.PostLogID, input.BodyMarkdown); err != nil {
+		return nil, err
+	}
+
+	article := newDevToArticle(input)
+	createdAt := time.Now().UTC()
+	story := &models.SocialPostStories{
+		UserID:              user.ID,
+		PostGenerationLogID: input.PostLogID,
+		Network:             models.NetworkDevTo,
+		PostContent:         article.BodyMarkdown,
+		Payload:             devToPayload(article),
+		CreatedAt:           createdAt,
+		UpdatedAt:           createdAt,
+		Status:              models.StoryStatusStarted,
+	}
+
+	result, err := s.devToClient.CreateArticle(ctx, user.DevToApiKey, article)
+	story.UpdatedAt = time.Now().UTC()
+	if err != nil {
+		story.Status = models.StoryStatusError
+		story.Error = err.Error()
+		story.ID, _ = s.storiesRepository.Create(ctx, story)
+		emitStoryResult(ctx, s.webhookService, user.ID, story)
+		return nil, err
+	}
+
+	story.Status = models.StoryStatusSuccess
+	story.ExternalPostID = strconv.FormatInt(result.ID, 10)
+	story.ExternalURL = result.URL
+	story.Response = result.Raw
+	id, err := s.storiesRepository.Create(ctx, story)
+	if err != nil {
+		return nil, err
+	}
+	story.ID = id
+
+	log.Logger.Info("dev.to article created",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("storyId", id.Hex()),
+		zap.String("articleId", story.ExternalPostID),
+		zap.Bool("published", result.Published),
+	)
+	if result.Published {
+		markReviewPublished(ctx, s.logRepository, input.PostLogID, user.ID)
+	}
+	emitStoryResult(ctx, s.webhookService, user.ID, story)
+	return story, nil
+}
+
+// UpdateArticle replaces a dev.to article previously created through PublishArticle.
+// The previous body is kept as a revision of the story when it changes. With the approval workflow the new body
+// must be the text of an approved post, the one the article came from unless PostLogID is given.
+func (s *devToService) UpdateArticle(ctx context.Context, user *models.User, storyID primitive.ObjectID, input DevToArticleInput) (*models.SocialPostStories, error) {
+	story, err := s.storiesRepository.GetByID(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if story == nil || story.UserID != user.ID {
+		return nil, ErrStoryNotFound
+	}
+	if story.Network != models.NetworkDevTo || story.Status != models.StoryStatusSuccess {
+		return nil, ErrStoryNotDevTo
+	}
+	articleID, err := strconv.ParseInt(story.ExternalPostID, 10, 64)
+	if err != nil {
+		return nil, ErrStoryNotDevTo
+	}
+	if user.DevToApiKey == "" {
+		return nil, ErrDevToNotConnected
+	}
+	postLogID := input.PostLogID
+	if postLogID.IsZero() {
+		postLogID = story.PostGenerationLogID
+	}
+	if err := ensureApprovedPost(ctx, s.logRepository, user, postLogID, input.BodyMarkdown); err != nil {
+		return nil, err
+	}
+
+	article := newDevToArticle(input)
+	result, err := s.devToClient.UpdateArticle(ctx, user.DevToApiKey, articleID, article)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	payload := devToPayload(article)
+	set := bson.M{
+		"postContent": article.BodyMarkdown,
+		"payload":     payload,
+		"response":    result.Raw,
+		"externalUrl": result.URL,
+	}
+	if postLogID != story.PostGenerationLogID {
+		set["postGenerationLogId"] = postLogID
+	}
+	var revision *models.PostRevision
+	if story.PostContent != article.BodyMarkdown {
+		revision = &models.PostRevision{Text: story.PostContent, LiveFrom: currentVersionLiveFrom(story), ReplacedAt: now}
+	}
+	if err := s.storiesRepository.UpdatePublished(ctx, storyID, set, revision); err != nil {
+		return nil, err
+	}
+
+	log.Logger.Info("dev.to article updated",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("storyId", storyID.Hex()),
+		zap.String("articleId", story.ExternalPostID),
+		zap.Bool("published", result.Published),
+	)
+	if result.Published {
+		markReviewPublished(ctx, s.logRepository, postLogID, user.ID)
+	}
+
+	if revision != nil {
+		story.Revisions = append(story.Revisions, *revision)
+		story.EditedAt = &now
+	}
+	story.PostGenerationLogID = postLogID
+	story.PostContent = article.BodyMarkdown
+	story.Payload = payload
+	story.Response = result.Raw
+	story.ExternalURL = result.URL
+	story.UpdatedAt = now
+	return story, nil
+}
+
+// newDevToArticle builds the article sent to dev.to. Tags are lowercased, as dev.to only accepts lowercase tags.
+func newDevToArticle(input DevToArticleInput) DevToArticle {
+	tags := make([]string, len(input.Tags))
+	for i, tag := range input.Tags {
+		tags[i] = strings.ToLower(strings.TrimSpace(tag))
+	}
+	return DevToArticle{
+		Title:        strings.TrimSpace(input.Title),
+		BodyMarkdown: input.BodyMarkdown,
+		Published:    input.Published,
+		Tags:         tags,
+		CanonicalURL: input.CanonicalURL,
+	}
+}
+
+// devToPayload is the article as recorded in the story payload, without the body already kept in PostContent
+func devToPayload(article DevToArticle) map[string]interface{} {
+	return map[string]interface{}{
+		"title":        article.Title,
+		"tags":         article.Tags,
+		"canonicalUrl": article.CanonicalURL,
+		"published":    article.Published,
+	}
+}
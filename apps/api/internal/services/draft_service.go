@@ -0,0 +1,546 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrDraftNotFound        = errors.New("draft not found")
+	ErrDraftVersionConflict = errors.New("draft was modified by another request")
+	ErrDraftPublished       = errors.New("draft is already published")
+	ErrDraftPartNotFound    = errors.New("draft part not found")
+	ErrDraftPublishing      = errors.New("draft is being published")
+	ErrScheduleInPast       = errors.New("scheduled time must be in the future")
+)
+
+// draftPublishLease is how long a scheduled draft may stay publishing before another run picks it up again
+const draftPublishLease = 10 * time.Minute
+
+// DraftInput holds the fields of a new draft
+type DraftInput struct {
+	PostLogID        primitive.ObjectID
+	EvergreenStoryID primitive.ObjectID
+	Article          *models.DraftArticle
+	Title            string
+	Text             string
+	Parts            []models.PostPart
+	Theme            string
+}
+
+// DraftPatch holds the fields to change on a draft; nil fields are left untouched
+type DraftPatch struct {
+	Title *string
+	Text  *string
+	Parts *[]models.PostPart
+	Theme *string
+}
+
+// DraftPartPatch holds the fields to change on a part of a draft; nil fields are left untouched and an
+// empty title removes it
+type DraftPartPatch struct {
+	Title *string
+	Text  *string
+}
+
+// DraftSchedule holds when and where a draft is published. With NextRecommended set, At is ignored and
+// the next recommended slot of the user in TimeZone is used.
+type DraftSchedule struct {
+	At              time.Time
+	NextRecommended bool
+	TimeZone        string
+	Targets         []models.DraftTarget
+}
+
+type DraftService interface {
+	Create(ctx context.Context, user *models.User, input DraftInput) (*models.Draft, error)
+	Get(ctx context.Context, userID, draftID primitive.ObjectID) (*models.Draft, error)
+	List(ctx context.Context, userID primitive.ObjectID, status models.DraftStatus, limit int) ([]models.Draft, error)
+	Update(ctx context.Context, userID, draftID primitive.ObjectID, version int64, patch DraftPatch) (*models.Draft, error)
+	UpdatePart(ctx context.Context, userID, draftID primitive.ObjectID, version int64, index int, patch DraftPartPatch) (*models.Draft, error)
+	Delete(ctx context.Context, userID, draftID primitive.ObjectID) error
+	Publish(ctx context.Context, user *models.User, draftID primitive.ObjectID, targets []PublishTarget) (*CrossPostResponse, error)
+	Schedule(ctx context.Context, userID, draftID primitive.ObjectID, version int64, schedule DraftSchedule) (*models.Draft, error)
+	Unschedule(ctx context.Context, userID, draftID primitive.ObjectID, version int64) (*models.Draft, error)
+	PublishDue(ctx context.Context) error
+}
+
+type draftService struct {
+	draftRepository   repositories.DraftRepository
+	logRepository     repositories.PostGenerationLogRepository
+	userRepository    repositories.UserRepository
+	storiesRepository repositories.SocialPostStoriesRepository
+	postService       PostService
+	insightsService   InsightsService
+}
+
+func NewDraftService(
+	draftRepo repositories.DraftRepository,
+	logRepo repositories.PostGenerationLogRepository,
+	userRepo repositories.UserRepository,
+	storiesRepo repositories.SocialPostStoriesRepository,
+	postService PostService,
+	insightsService InsightsService,
+) DraftService {
+	return &draftService{
+		draftRepository:   draftRepo,
+		logRepository:     logRepo,
+		userRepository:    userRepo,
+		storiesRepository: storiesRepo,
+		postService:       postService,
+		insightsService:   insightsService,
+	}
+}
+
+// Create stores a new draft. When it is linked to a generated post and has no text or parts yet,
+// the generated output is used as the starting content.
+func (s *draftService) Create(ctx context.Context, user *models.User, input DraftInput) (*models.Draft, error) {
+	now := time.Now().UTC()
+	draft := &models.Draft{
+		UserID:           user.ID,
+		EvergreenStoryID: input.EvergreenStoryID,
+		Article:          input.Article,
+		Title:            input.Title,
+		Text:             input.Text,
+		Parts:            input.Parts,
+		Theme:            input.Theme,
+		Status:           models.DraftStatusDraft,
+		Version:          1,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	if err := validateCarouselParts(draft.Parts); err != nil {
+		return nil, err
+	}
+
+	if input.PostLogID != primitive.NilObjectID {
+		postLog, err := s.logRepository.GetByID(ctx, input.PostLogID)
+		if err != nil {
+			return nil, err
+		}
+		if postLog == nil || postLog.UserID != user.ID {
+			return nil, ErrPostNotFound
+		}
+		draft.PostGenerationLogID = postLog.ID
+		if draft.Text == "" && len(draft.Parts) == 0 {
+			if len(postLog.Parts) > 0 {
+				draft.Parts = postLog.Parts
+			} else {
+				draft.Text = postLog.Output
+			}
+		}
+	}
+
+	id, err := s.draftRepository.Create(ctx, draft)
+	if err != nil {
+		return nil, err
+	}
+	draft.ID = id
+
+	log.Logger.Info("Draft created",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("draftId", id.Hex()),
+		zap.String("postLogId", draft.PostGenerationLogID.Hex()),
+	)
+	return draft, nil
+}
+
+func (s *draftService) Get(ctx context.Context, userID, draftID primitive.ObjectID) (*models.Draft, error) {
+	draft, err := s.draftRepository.GetByID(ctx, draftID)
+	if err != nil {
+		return nil, err
+	}
+	if draft == nil || draft.UserID != userID {
+		return nil, ErrDraftNotFound
+	}
+	return draft, nil
+}
+
+func (s *draftService) List(ctx context.Context, userID primitive.ObjectID, status models.DraftStatus, limit int) ([]models.Draft, error) {
+	drafts, err := s.draftRepository.ListByUser(ctx, userID, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	if drafts == nil {
+		drafts = []models.Draft{}
+	}
+	return drafts, nil
+}
+
+// Update applies patch if the draft is still at version, so two editors never overwrite each other silently
+func (s *draftService) Update(ctx context.Context, userID, draftID primitive.ObjectID, version int64, patch DraftPatch) (*models.Draft, error) {
+	draft, err := s.editableDraft(ctx, userID, draftID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	set := bson.M{}
+	if patch.Title != nil {
+		set["title"] = *patch.Title
+	}
+	if patch.Text != nil {
+		set["text"] = *patch.Text
+	}
+	if patch.Parts != nil {
+		if err := validateCarouselParts(*patch.Parts); err != nil {
+			return nil, err
+		}
+		set["parts"] = *patch.Parts
+	}
+	if patch.Theme != nil {
+		set["theme"] = *patch.Theme
+	}
+	return s.applyUpdate(ctx, draft, set)
+}
+
+// UpdatePart changes a single part of a multi-part draft
+func (s *draftService) UpdatePart(ctx context.Context, userID, draftID primitive.ObjectID, version int64, index int, patch DraftPartPatch) (*models.Draft, error) {
+	draft, err := s.editableDraft(ctx, userID, draftID, version)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(draft.Parts) {
+		return nil, ErrDraftPartNotFound
+	}
+
+	part := draft.Parts[index]
+	set := bson.M{}
+	if patch.Title != nil {
+		part.Title = *patch.Title
+		if part.Title == "" {
+			set[fmt.Sprintf("parts.%d.title", index)] = nil
+		} else {
+			set[fmt.Sprintf("parts.%d.title", index)] = part.Title
+		}
+	}
+	if patch.Text != nil {
+		part.Text = *patch.Text
+		set[fmt.Sprintf("parts.%d.text", index)] = part.Text
+	}
+	if err := validateCarouselParts([]models.PostPart{part}); err != nil {
+		return nil, fmt.Errorf("%w: part %d is too long", ErrSlideTextTooLong, index+1)
+	}
+	return s.applyUpdate(ctx, draft, set)
+}
+
+// editableDraft returns the draft if it belongs to userID, is not published or being published and is still at version
+func (s *draftService) editableDraft(ctx context.Context, userID, draftID primitive.ObjectID, version int64) (*models.Draft, error) {
+	draft, err := s.Get(ctx, userID, draftID)
+	if err != nil {
+		return nil, err
+	}
+	switch draft.Status {
+	case models.DraftStatusPublished:
+		return nil, ErrDraftPublished
+	case models.DraftStatusPublishing:
+		return nil, ErrDraftPublishing
+	}
+	if draft.Version != version {
+		return nil, ErrDraftVersionConflict
+	}
+	return draft, nil
+}
+
+func (s *draftService) applyUpdate(ctx context.Context, draft *models.Draft, set bson.M) (*models.Draft, error) {
+	if len(set) == 0 {
+		return draft, nil
+	}
+	updated, err := s.draftRepository.UpdateVersioned(ctx, draft.ID, draft.Version, set)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrDraftVersionConflict
+	}
+	return updated, nil
+}
+
+func (s *draftService) Delete(ctx context.Context, userID, draftID primitive.ObjectID) error {
+	if _, err := s.Get(ctx, userID, draftID); err != nil {
+		return err
+	}
+	return s.draftRepository.Delete(ctx, draftID)
+}
+
+// Publish publishes the current text of a draft and marks it as published once at least one target succeeded.
+// The draft is moved to publishing first, so it cannot be edited or published again while its targets are sent.
+func (s *draftService) Publish(ctx context.Context, user *models.User, draftID primitive.ObjectID, targets []PublishTarget) (*CrossPostResponse, error) {
+	draft, err := s.Get(ctx, user.ID, draftID)
+	if err != nil {
+		return nil, err
+	}
+	switch draft.Status {
+	case models.DraftStatusPublished:
+		return nil, ErrDraftPublished
+	case models.DraftStatusPublishing:
+		return nil, ErrDraftPublishing
+	}
+
+	claimed, err := s.applyUpdate(ctx, draft, bson.M{"status": models.DraftStatusPublishing})
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.publish(ctx, user, claimed, targets)
+	if err != nil || resp.Status == "failed" {
+		if _, releaseErr := s.draftRepository.UpdateVersioned(ctx, claimed.ID, claimed.Version, bson.M{"status": draft.Status}); releaseErr != nil {
+			log.Logger.Error("Failed to release draft after publish failed", zap.String("draftId", draftID.Hex()), zap.Error(releaseErr))
+		}
+	}
+	return resp, err
+}
+
+// publish sends a draft claimed as publishing to its targets and marks it as published
+func (s *draftService) publish(ctx context.Context, user *models.User, draft *models.Draft, targets []PublishTarget) (*CrossPostResponse, error) {
+	resp, err := s.postService.PublishDraft(ctx, user, draft, targets)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status == "failed" {
+		return resp, nil
+	}
+
+	now := time.Now().UTC()
+	set := bson.M{"status": models.DraftStatusPublished, "publishedAt": now, "scheduledAt": nil, "scheduleError": nil}
+	updated, err := s.draftRepository.UpdateVersioned(ctx, draft.ID, draft.Version, set)
+	switch {
+	case err != nil:
+		log.Logger.Warn("Failed to mark draft as published",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("draftId", draft.ID.Hex()),
+			zap.Error(err),
+		)
+	case updated == nil:
+		// The claim was lost, to the scheduler taking over an expired lease or to a deletion: the
+		// targets were published all the same, so the draft is marked as published whatever its version
+		log.Logger.Error("Draft changed while it was being published",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("draftId", draft.ID.Hex()),
+			zap.Int64("version", draft.Version),
+		)
+		if err := s.draftRepository.MarkPublished(ctx, draft.ID, now); err != nil {
+			log.Logger.Warn("Failed to mark draft as published", zap.String("draftId", draft.ID.Hex()), zap.Error(err))
+		}
+	}
+	return resp, nil
+}
+
+// Schedule sets the draft to be published to targets at the scheduled time by the PublishDue job.
+// A scheduled draft can still be edited, rescheduled or unscheduled until then.
+func (s *draftService) Schedule(ctx context.Context, userID, draftID primitive.ObjectID, version int64, schedule DraftSchedule) (*models.Draft, error) {
+	draft, err := s.editableDraft(ctx, userID, draftID, version)
+	if err != nil {
+		return nil, err
+	}
+
+	at := schedule.At.UTC()
+	if schedule.NextRecommended {
+		at, err = s.insightsService.NextRecommendedSlot(ctx, userID, schedule.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !at.After(time.Now()) {
+		return nil, ErrScheduleInPast
+	}
+	if err := s.ensureSchedulable(ctx, userID, draft, schedule.Targets); err != nil {
+		return nil, err
+	}
+
+	set := bson.M{
+		"status":          models.DraftStatusScheduled,
+		"scheduledAt":     at,
+		"scheduleTargets": schedule.Targets,
+		"scheduleError":   nil,
+	}
+	updated, err := s.applyUpdate(ctx, draft, set)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Logger.Info("Draft scheduled",
+		zap.String("userId", userID.Hex()),
+		zap.String("draftId", draftID.Hex()),
+		zap.Time("scheduledAt", at),
+		zap.Bool("nextRecommended", schedule.NextRecommended),
+	)
+	s.moveReview(ctx, draft, models.ReviewStatusApproved, models.ReviewStatusScheduled, userID)
+	return updated, nil
+}
+
+// ensureSchedulable applies the approval checks of publishing when the draft is scheduled, so that a draft that
+// could not be published when due is refused up front. They run again when it is published.
+func (s *draftService) ensureSchedulable(ctx context.Context, userID primitive.ObjectID, draft *models.Draft, targets []models.DraftTarget) error {
+	user, err := s.userRepository.FindByID(ctx, userID.Hex())
+	if err != nil {
+		return err
+	}
+	if user == nil || !user.RequiresApproval() {
+		return nil
+	}
+	if draft.PostGenerationLogID == primitive.NilObjectID {
+		// Evergreen reshares are checked against the text already published when they are sent
+		if draft.EvergreenStoryID.IsZero() {
+			return fmt.Errorf("%w: only generated posts go through review, so a standalone draft cannot be scheduled", ErrPostNotApproved)
+		}
+		return nil
+	}
+
+	postLog, err := s.logRepository.GetByID(ctx, draft.PostGenerationLogID)
+	if err != nil {
+		return err
+	}
+	if postLog == nil || postLog.UserID != user.ID {
+		return ErrPostNotFound
+	}
+	if err := ensurePostApproved(user, postLog); err != nil {
+		return err
+	}
+	if err := ensureApprovedDraft(user, postLog, draft); err != nil {
+		return err
+	}
+	for _, target := range targets {
+		if err := ensureApprovedText(user, postLog, target.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// moveReview moves the review of the post a draft comes from between the approved and scheduled statuses.
+// It is a no-op for standalone drafts and for posts outside the workflow or in another status.
+func (s *draftService) moveReview(ctx context.Context, draft *models.Draft, from, to models.ReviewStatus, actorID primitive.ObjectID) {
+	if draft.PostGenerationLogID == primitive.NilObjectID {
+		return
+	}
+	_, err := s.logRepository.TransitionReview(ctx, draft.PostGenerationLogID, from, models.ReviewEvent{
+		From:    from,
+		To:      to,
+		ActorID: actorID,
+		At:      time.Now().UTC(),
+	})
+	if err != nil {
+		log.Logger.Warn("Post review status not updated",
+			zap.String("postLogId", draft.PostGenerationLogID.Hex()),
+			zap.String("to", string(to)),
+			zap.Error(err),
+		)
+	}
+}
+
+// Unschedule turns a scheduled draft back into a plain draft, and its post back into an approved one
+func (s *draftService) Unschedule(ctx context.Context, userID, draftID primitive.ObjectID, version int64) (*models.Draft, error) {
+	draft, err := s.editableDraft(ctx, userID, draftID, version)
+	if err != nil {
+		return nil, err
+	}
+	if draft.Status != models.DraftStatusScheduled {
+		return draft, nil
+	}
+	set := bson.M{"status": models.DraftStatusDraft, "scheduledAt": nil, "scheduleTargets": nil}
+	updated, err := s.applyUpdate(ctx, draft, set)
+	if err != nil {
+		return nil, err
+	}
+	s.moveReview(ctx, draft, models.ReviewStatusScheduled, models.ReviewStatusApproved, userID)
+	return updated, nil
+}
+
+// publishScheduled publishes a claimed scheduled draft and returns why it failed, if it did. A draft claimed
+// again after its lease expired may already be on some networks, so only the targets without a published
+// story are sent.
+func (s *draftService) publishScheduled(ctx context.Context, user *models.User, draft *models.Draft) string {
+	stories, err := s.storiesRepository.ListPublishedByDraft(ctx, draft.ID)
+	if err != nil {
+		return err.Error()
+	}
+	published := make(map[string]bool, len(stories))
+	for _, story := range stories {
+		published[story.Network] = true
+	}
+
+	var targets []PublishTarget
+	for _, t := range draft.ScheduleTargets {
+		if !published[t.Network] {
+			targets = append(targets, PublishTarget{Network: t.Network, Text: t.Text, Campaign: t.Campaign})
+		}
+	}
+	if len(stories) > 0 && len(targets) == 0 {
+		log.Logger.Warn("Reclaimed draft was already published, marking it as published",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("draftId", draft.ID.Hex()),
+			zap.Int("stories", len(stories)),
+		)
+		if err := s.draftRepository.MarkPublished(ctx, draft.ID, stories[0].CreatedAt); err != nil {
+			return err.Error()
+		}
+		return ""
+	}
+	if len(targets) == 0 {
+		return "no targets to publish to"
+	}
+
+	resp, err := s.publish(ctx, user, draft, targets)
+	if err != nil {
+		return err.Error()
+	}
+	if resp.Status == "failed" {
+		return "all targets failed to publish"
+	}
+	return ""
+}
+
+// PublishDue publishes the scheduled drafts whose time has come. A draft that fails to publish goes back
+// to being a plain draft with the reason in ScheduleError, so its author can fix it and schedule it again.
+func (s *draftService) PublishDue(ctx context.Context) error {
+	published, failed := 0, 0
+	for ctx.Err() == nil {
+		draft, err := s.draftRepository.ClaimDueScheduled(ctx, time.Now().UTC(), draftPublishLease)
+		if err != nil {
+			return err
+		}
+		if draft == nil {
+			break
+		}
+
+		reason := ""
+		user, err := s.userRepository.FindByID(ctx, draft.UserID.Hex())
+		switch {
+		case err != nil:
+			reason = err.Error()
+		case user == nil:
+			reason = "user not found"
+		default:
+			reason = s.publishScheduled(ctx, user, draft)
+		}
+
+		if reason == "" {
+			published++
+			continue
+		}
+		failed++
+		log.Logger.Warn("Scheduled draft failed to publish",
+			zap.String("userId", draft.UserID.Hex()),
+			zap.String("draftId", draft.ID.Hex()),
+			zap.String("reason", reason),
+		)
+		set := bson.M{"status": models.DraftStatusDraft, "scheduledAt": nil, "scheduleError": reason}
+		if _, err := s.draftRepository.UpdateVersioned(ctx, draft.ID, draft.Version, set); err != nil {
+			log.Logger.Error("Failed to release scheduled draft", zap.String("draftId", draft.ID.Hex()), zap.Error(err))
+		}
+		s.moveReview(ctx, draft, models.ReviewStatusScheduled, models.ReviewStatusApproved, draft.UserID)
+	}
+
+	if published+failed > 0 {
+		log.Logger.Info("Scheduled drafts processed", zap.Int("published", published), zap.Int("failed", failed))
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var ErrStoryNotEvergreenable = errors.New("only successfully published original LinkedIn posts can be evergreen")
+
+const (
+	// evergreenRewordMaxTokens leaves room for a reworded post as long as LinkedIn allows
+	evergreenRewordMaxTokens = 1024
+	// evergreenQueueLease is how long a story being queued is held before another run may retry it
+	evergreenQueueLease = time.Hour
+)
+
+// EvergreenInput holds the rules of an evergreen post
+type EvergreenInput struct {
+	Enabled        bool
+	MinDaysBetween int
+	MaxRepetitions int
+	Reword         bool
+}
+
+type EvergreenService interface {
+	Configure(ctx context.Context, userID, storyID primitive.ObjectID, input EvergreenInput) (*models.SocialPostStories, error)
+	ListReshares(ctx context.Context, userID, storyID primitive.ObjectID) ([]models.SocialPostStories, error)
+	QueueDue(ctx context.Context) error
+}
+
+type evergreenService struct {
+	storiesRepository repositories.SocialPostStoriesRepository
+	userRepository    repositories.UserRepository
+	logRepository     repositories.PostGenerationLogRepository
+	draftService      DraftService
+	reviewService     ReviewService
+	openAIClient      *OpenAIClient
+}
+
+func NewEvergreenService(
+	storiesRepo repositories.SocialPostStoriesRepository,
+	userRepo repositories.UserRepository,
+	logRepo repositories.PostGenerationLogRepository,
+	draftService DraftService,
+	reviewService ReviewService,
+	openAIClient *OpenAIClient,
+) EvergreenService {
+	return &evergreenService{
+		storiesRepository: storiesRepo,
+		userRepository:    userRepo,
+		logRepository:     logRepo,
+		draftService:      draftService,
+		reviewService:     reviewService,
+		openAIClient:      openAIClient,
+	}
+}
+
+// Configure sets the evergreen rules of a published post. Reshares already queued still count towards
+// MaxRepetitions, and the next reshare waits MinDaysBetween days from the last one.
+func (s *evergreenService) Configure(ctx context.Context, userID, storyID primitive.ObjectID, input EvergreenInput) (*models.SocialPostStories, error) {
+	story, err := s.storiesRepository.GetByID(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if story == nil || story.UserID != userID {
+		return nil, ErrStoryNotFound
+	}
+	if story.Network != models.NetworkLinkedIn || story.Status != models.StoryStatusSuccess || !story.OriginalStoryID.IsZero() {
+		return nil, ErrStoryNotEvergreenable
+	}
+
+	settings := &models.EvergreenSettings{
+		Enabled:        input.Enabled,
+		MinDaysBetween: input.MinDaysBetween,
+		MaxRepetitions: input.MaxRepetitions,
+		Reword:         input.Reword,
+	}
+	lastShared := story.CreatedAt
+	if story.Evergreen != nil {
+		settings.Reshares = story.Evergreen.Reshares
+		settings.LastQueuedAt = story.Evergreen.LastQueuedAt
+		if settings.LastQueuedAt != nil {
+			lastShared = *settings.LastQueuedAt
+		}
+	}
+	settings.NextEligibleAt = lastShared.AddDate(0, 0, input.MinDaysBetween)
+
+	if err := s.storiesRepository.SetEvergreen(ctx, storyID, settings); err != nil {
+		return nil, err
+	}
+
+	log.Logger.Info("Evergreen settings updated",
+		zap.String("userId", userID.Hex()),
+		zap.String("storyId", storyID.Hex()),
+		zap.Bool("enabled", settings.Enabled),
+		zap.Time("nextEligibleAt", settings.NextEligibleAt),
+	)
+	story.Evergreen = settings
+	return story, nil
+}
+
+// ListReshares returns the stories published as reshares of an evergreen post
+func (s *evergreenService) ListReshares(ctx context.Context, userID, storyID primitive.ObjectID) ([]models.SocialPostStories, error) {
+	story, err := s.storiesRepository.GetByID(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if story == nil || story.UserID != userID {
+		return nil, ErrStoryNotFound
+	}
+
+	reshares, err := s.storiesRepository.ListByOriginal(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if reshares == nil {
+		reshares = []models.SocialPostStories{}
+	}
+	return reshares, nil
+}
+
+// QueueDue puts every evergreen post that may be reshared back into its author's schedule, as a draft
+// scheduled at the author's next recommended slot. The draft is published by the scheduled drafts job,
+// which records the reshare as a new story tied to the original one. A reworded reshare of an account
+// with the approval workflow is submitted for review instead, and scheduled by its author once approved.
+func (s *evergreenService) QueueDue(ctx context.Context) error {
+	queued := 0
+	for ctx.Err() == nil {
+		story, err := s.storiesRepository.ClaimDueEvergreen(ctx, time.Now().UTC(), evergreenQueueLease)
+		if err != nil {
+			return err
+		}
+		if story == nil {
+			break
+		}
+		if err := s.queue(ctx, story); err != nil {
+			log.Logger.Warn("Failed to queue evergreen reshare",
+				zap.String("userId", story.UserID.Hex()),
+				zap.String("storyId", story.ID.Hex()),
+				zap.Error(err),
+			)
+			continue
+		}
+		queued++
+	}
+
+	if queued > 0 {
+		log.Logger.Info("Evergreen reshares queued", zap.Int("queued", queued))
+	}
+	return nil
+}
+
+func (s *evergreenService) queue(ctx context.Context, story *models.SocialPostStories) error {
+	user, err := s.userRepository.FindByID(ctx, story.UserID.Hex())
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errors.New("user not found")
+	}
+
+	input := DraftInput{
+		EvergreenStoryID: story.ID,
+		Title:            "Evergreen: " + story.CreatedAt.Format("02/01/2006"),
+		Text:             story.PostContent,
+		Parts:            story.Parts,
+	}
+	// Multi-part posts are reshared as they are: rewording every slide would need a review of its own
+	if story.Evergreen.Reword && len(story.Parts) == 0 {
+		postLog, err := s.reword(ctx, user, story.PostContent)
+		if err != nil {
+			log.Logger.Warn("Failed to reword evergreen post, resharing the original text",
+				zap.String("storyId", story.ID.Hex()),
+				zap.Error(err),
+			)
+		} else {
+			input.PostLogID = postLog.ID
+			input.Text = postLog.Output
+		}
+	}
+
+	draft, err := s.draftService.Create(ctx, user, input)
+	if err != nil {
+		return err
+	}
+	if err := s.storiesRepository.RecordEvergreenReshare(ctx, story.ID, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	// The reworded text was never published, so it needs approval like any generated post
+	if !input.PostLogID.IsZero() && user.RequiresApproval() {
+		if _, err := s.reviewService.Transition(ctx, user, input.PostLogID, ReviewActionSubmit, ""); err != nil {
+			return err
+		}
+		log.Logger.Info("Evergreen reshare submitted for review",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("storyId", story.ID.Hex()),
+			zap.String("draftId", draft.ID.Hex()),
+			zap.String("postLogId", input.PostLogID.Hex()),
+		)
+		return nil
+	}
+
+	schedule := DraftSchedule{
+		NextRecommended: true,
+		Targets:         []models.DraftTarget{{Network: story.Network}},
+	}
+	draft, err = s.draftService.Schedule(ctx, user.ID, draft.ID, draft.Version, schedule)
+	if err != nil {
+		return err
+	}
+
+	log.Logger.Info("Evergreen reshare queued",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("storyId", story.ID.Hex()),
+		zap.String("draftId", draft.ID.Hex()),
+		zap.Int("reshare", story.Evergreen.Reshares),
+		zap.Timep("scheduledAt", draft.ScheduledAt),
+	)
+	return nil
+}
+
+// reword asks the model for a new wording of text, keeping its message, links and hashtags, and records it
+// as a generated post, so it can be reviewed and its publication tracked like any other
+func (s *evergreenService) reword(ctx context.Context, user *models.User, text string) (*models.PostGenerationLog, error) {
+	prompt := "Reescreva o post abaixo para publicá-lo novamente nas redes sociais. " +
+		"Mantenha a mensagem, o tom, os links e as hashtags, mas mude a redação para que o texto não fique idêntico ao original. " +
+		"Responda apenas com o novo texto.\n\n" + text
+
+	output, usedModel, usage, err := s.openAIClient.GenerateTextWithLimit(ctx, user.OpenAiApiKey, userOpenAIModel(user), prompt, evergreenRewordMaxTokens)
+	if err != nil {
+		return nil, err
+	}
+	output = strings.TrimSpace(output)
+	if output == "" {
+		return nil, errors.New("the model returned an empty text")
+	}
+
+	postLog := &models.PostGenerationLog{
+		UserID:    user.ID,
+		Input:     text,
+		Output:    output,
+		Model:     usedModel,
+		Usage:     usage,
+		Status:    models.PostStatusSuccess,
+		CreatedAt: time.Now().UTC(),
+	}
+	postLog.ID, err = s.logRepository.Create(ctx, postLog)
+	if err != nil {
+		return nil, err
+	}
+	return postLog, nil
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/config"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// ErrFeedNotModified is returned by ConditionalArticleSource when the upstream did not change
+var ErrFeedNotModified = errors.New("feed not modified")
+
+// feedFetchTimeout bounds a refresh, which runs detached from the request that started it
+const feedFetchTimeout = 20 * time.Second
+
+const feedUserAgent = "PostPilot/1.0 (+feed reader)"
+
+// FeedValidators are the HTTP validators of the last response read from a source
+type FeedValidators struct {
+	ETag         string
+	LastModified string
+}
+
+// ConditionalArticleSource is implemented by sources whose upstream supports conditional requests.
+// FetchConditional sends the validators of the cached result and returns ErrFeedNotModified on a 304.
+type ConditionalArticleSource interface {
+	FetchConditional(ctx context.Context, ds models.DataSource, limit int, validators FeedValidators) ([]models.Article, FeedValidators, error)
+}
+
+// feedCache keeps the last result of every data source in Mongo, shared by all users that follow it.
+// A fresh entry is served as is; a stale one is revalidated with the upstream, and concurrent
+// refreshes of the same source are merged into a single request.
+type feedCache struct {
+	repository repositories.FeedCacheRepository
+	group      singleflight.Group
+	ttl        time.Duration
+	retention  time.Duration
+}
+
+func newFeedCache(repository repositories.FeedCacheRepository) *feedCache {
+	cfg := config.Get().Feeds
+	return &feedCache{
+		repository: repository,
+		ttl:        cfg.CacheTTL,
+		retention:  cfg.CacheRetention,
+	}
+}
+
+// fetch returns up to limit articles of a data source. When the upstream fails and a stale entry exists,
+// its articles are returned along with the error.
+func (c *feedCache) fetch(ctx context.Context, source ArticleSource, ds models.DataSource, limit int) ([]models.Article, error) {
+	key := feedCacheKey(source.Describe(), ds)
+	entry, err := c.repository.Get(ctx, key)
+	if err != nil {
+		entry = nil // a cache failure only costs a request to the upstream
+	}
+	if entry != nil {
+		// A recent failure is not retried before the TTL either
+		if entry.LastErrorAt != nil && time.Since(*entry.LastErrorAt) < c.ttl {
+			return firstArticles(entry.Articles, limit), errors.New(entry.LastError)
+		}
+		if entry.LastError == "" && entry.Limit >= limit && time.Since(entry.FetchedAt) < c.ttl {
+			return firstArticles(entry.Articles, limit), nil
+		}
+	}
+
+	result, err, _ := c.group.Do(key, func() (interface{}, error) {
+		refreshCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), feedFetchTimeout)
+		defer cancel()
+		return c.refresh(refreshCtx, key, source, ds, limit, entry)
+	})
+	articles, _ := result.([]models.Article)
+	return firstArticles(articles, limit), err
+}
+
+func (c *feedCache) refresh(ctx context.Context, key string, source ArticleSource, ds models.DataSource, limit int, entry *models.FeedCacheEntry) ([]models.Article, error) {
+	var validators FeedValidators
+	if entry != nil && entry.Limit >= limit {
+		validators = FeedValidators{ETag: entry.ETag, LastModified: entry.LastModified}
+	}
+
+	var articles []models.Article
+	var err error
+	if conditional, ok := source.(ConditionalArticleSource); ok {
+		articles, validators, err = conditional.FetchConditional(ctx, ds, limit, validators)
+	} else {
+		articles, err = source.Fetch(ctx, ds, limit)
+		validators = FeedValidators{}
+	}
+
+	now := time.Now().UTC()
+	if errors.Is(err, ErrFeedNotModified) && entry != nil && entry.Articles != nil {
+		_ = c.repository.Touch(ctx, key, now, now.Add(c.retention))
+		log.Logger.Debug("Feed not modified", zap.String("key", key))
+		return entry.Articles, nil
+	}
+	if err != nil {
+		_ = c.repository.SetError(ctx, key, ds.Type, ds.Url, err.Error(), now, now.Add(c.retention))
+		if entry != nil {
+			log.Logger.Warn("Failed to refresh feed, serving stale articles", zap.String("key", key), zap.Error(err))
+			return entry.Articles, err
+		}
+		return nil, err
+	}
+
+	_ = c.repository.Save(ctx, &models.FeedCacheEntry{
+		Key:          key,
+		Type:         ds.Type,
+		Url:          ds.Url,
+		Articles:     articles,
+		Limit:        limit,
+		ETag:         validators.ETag,
+		LastModified: validators.LastModified,
+		FetchedAt:    now,
+		ExpiresAt:    now.Add(c.retention),
+	})
+	return articles, nil
+}
+
+// feedCacheKey identifies the result of a data source by the parts of its configuration the source reads:
+// the normalized URL when the type requires one, the tags when it supports them and the options it takes
+func feedCacheKey(desc ArticleSourceType, ds models.DataSource) string {
+	key := string(desc.Type)
+	if desc.URLRequired {
+		key += "|" + normalizeFeedURL(ds.Url)
+	}
+	if desc.SupportsTags && len(ds.Tags) > 0 {
+		tags := make([]string, len(ds.Tags))
+		for i, tag := range ds.Tags {
+			tags[i] = strings.ToLower(strings.TrimSpace(tag))
+		}
+		key += "|" + strings.Join(tags, ",")
+	}
+	for _, option := range desc.Options {
+		if value := dataSourceOption(ds, option); value != "" {
+			key += "|" + option + "=" + value
+		}
+	}
+	return key
+}
+
+// normalizeFeedURL lowercases the scheme and host and drops the fragment, default ports and a trailing slash
+func normalizeFeedURL(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return strings.TrimSpace(raw)
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	if port := u.Port(); port != "" && !(u.Scheme == "http" && port == "80") && !(u.Scheme == "https" && port == "443") {
+		host += ":" + port
+	}
+	u.Host = host
+	u.Fragment = ""
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u.String()
+}
+
+func firstArticles(articles []models.Article, limit int) []models.Article {
+	if len(articles) > limit {
+		return articles[:limit]
+	}
+	return articles
+}
+
+// conditionalGet requests endpoint with the given validators. It returns ErrFeedNotModified on a 304 and
+// an error on any other non-2xx status; otherwise the caller must close the response body.
+func conditionalGet(ctx context.Context, endpoint string, validators FeedValidators) (*http.Response, FeedValidators, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, validators, err
+	}
+	req.Header.Set("User-Agent", feedUserAgent)
+	if validators.ETag != "" {
+		req.Header.Set("If-None-Match", validators.ETag)
+	}
+	if validators.LastModified != "" {
+		req.Header.Set("If-Modified-Since", validators.LastModified)
+	}
+
+	resp, err := articleHTTPClient.Do(req)
+	if err != nil {
+		return nil, validators, err
+	}
+	if resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		return nil, validators, ErrFeedNotModified
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		resp.Body.Close()
+		return nil, validators, fmt.Errorf("%s returned status %d", endpoint, resp.StatusCode)
+	}
+	return resp, FeedValidators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}, nil
+}
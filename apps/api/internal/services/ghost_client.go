@@ -0,0 +1,169 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/postpilot/api/internal/httpclient"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+const (
+	ghostAcceptVersion = "v5.0"
+	// ghostTokenTTL is the lifetime of the Admin API tokens; Ghost rejects tokens valid for more than 5 minutes
+	ghostTokenTTL = 5 * time.Minute
+)
+
+var ErrInvalidGhostAdminKey = errors.New("Ghost Admin API key must be in the id:secret format")
+
+// GhostClient publishes to Ghost sites through the Admin API, authenticated with short-lived JWTs signed with the Admin API key
+type GhostClient struct {
+	httpClient *http.Client
+}
+
+// NewGhostClient creates a Ghost client. Sites are given by users, so client should come from httpclient.NewPublic.
+func NewGhostClient(client *httpclient.HTTPClient) *GhostClient {
+	return &GhostClient{httpClient: client.Client()}
+}
+
+// Verify checks the Admin API key by listing a post and returns the site title
+func (c *GhostClient) Verify(ctx context.Context, conn *models.BlogConnection) (string, error) {
+	if _, err := c.do(ctx, conn, http.MethodGet, "/posts/?limit=1&fields=id", nil); err != nil {
+		return "", err
+	}
+
+	raw, err := c.do(ctx, conn, http.MethodGet, "/site/", nil)
+	if err != nil {
+		return "", nil
+	}
+	var site struct {
+		Site struct {
+			Title string `json:"title"`
+		} `json:"site"`
+	}
+	_ = json.Unmarshal(raw, &site)
+	return site.Site.Title, nil
+}
+
+// Publish creates a post from HTML. Ghost creates missing tags by name and takes the feature image by URL.
+func (c *GhostClient) Publish(ctx context.Context, conn *models.BlogConnection, article BlogArticle) (*BlogPostResult, error) {
+	post := map[string]interface{}{
+		"title":  article.Title,
+		"html":   article.HTML,
+		"status": "draft",
+	}
+	if article.Published {
+		post["status"] = "published"
+	}
+	if len(article.Tags) > 0 {
+		tags := make([]map[string]string, len(article.Tags))
+		for i, tag := range article.Tags {
+			tags[i] = map[string]string{"name": tag}
+		}
+		post["tags"] = tags
+	}
+	if article.FeatureImageURL != "" {
+		post["feature_image"] = article.FeatureImageURL
+	}
+
+	body, err := json.Marshal(map[string]interface{}{"posts": []interface{}{post}})
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.do(ctx, conn, http.MethodPost, "/posts/?source=html", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var created struct {
+		Posts []map[string]interface{} `json:"posts"`
+	}
+	if err := json.Unmarshal(raw, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode Ghost response: %w", err)
+	}
+	if len(created.Posts) == 0 {
+		return nil, errors.New("Ghost returned no post")
+	}
+	created0 := created.Posts[0]
+	id, _ := created0["id"].(string)
+	if id == "" {
+		return nil, errors.New("Ghost returned no post ID")
+	}
+	postURL, _ := created0["url"].(string)
+	status, _ := created0["status"].(string)
+	return &BlogPostResult{ID: id, URL: postURL, Status: status, Raw: created0}, nil
+}
+
+func (c *GhostClient) do(ctx context.Context, conn *models.BlogConnection, method, endpoint string, body io.Reader) ([]byte, error) {
+	token, err := ghostAdminToken(conn.AdminAPIKey, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(conn.SiteURL, "/")+"/ghost/api/admin"+endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Ghost "+token)
+	req.Header.Set("Accept-Version", ghostAcceptVersion)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("Ghost request failed", zap.String("host", req.URL.Host), zap.String("endpoint", endpoint), zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		log.Logger.Warn("Ghost Admin API key rejected", zap.String("host", req.URL.Host), zap.String("endpoint", endpoint), zap.Int("statusCode", resp.StatusCode))
+		return nil, ErrBlogUnauthorized
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Logger.Error("Ghost API error",
+			zap.String("host", req.URL.Host),
+			zap.String("endpoint", endpoint),
+			zap.Int("statusCode", resp.StatusCode),
+			zap.String("response", string(respBody)),
+		)
+		return nil, fmt.Errorf("Ghost API error (%d)", resp.StatusCode)
+	}
+	return respBody, nil
+}
+
+// ghostAdminToken signs an Admin API token with the secret half of an "id:secret" Admin API key
+func ghostAdminToken(adminAPIKey string, now time.Time) (string, error) {
+	id, secretHex, ok := strings.Cut(adminAPIKey, ":")
+	if !ok || id == "" {
+		return "", ErrInvalidGhostAdminKey
+	}
+	secret, err := hex.DecodeString(secretHex)
+	if err != nil || len(secret) == 0 {
+		return "", ErrInvalidGhostAdminKey
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iat": now.Unix(),
+		"exp": now.Add(ghostTokenTTL).Unix(),
+		"aud": "/admin/",
+	})
+	token.Header["kid"] = id
+	return token.SignedString(secret)
+}
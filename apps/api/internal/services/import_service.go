@@ -0,0 +1,324 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrImportNotFound       = errors.New("import not found")
+	ErrInvalidImportFile    = errors.New("invalid import file")
+	ErrImportEmpty          = errors.New("import file has no rows")
+	ErrImportTooManyRows    = fmt.Errorf("import file has more than %d rows", postImportMaxRows)
+	ErrImportHasInvalidRows = errors.New("import has invalid rows, nothing was imported")
+)
+
+// postImportMaxRows keeps an import within a single batch insert of a reasonable size
+const postImportMaxRows = 500
+
+// importTimeLayouts are the layouts accepted for scheduled times without an offset, read in the import time zone
+var importTimeLayouts = []string{"2006-01-02 15:04", "2006-01-02T15:04", "2006-01-02 15:04:05", "2006-01-02T15:04:05"}
+
+// ImportOptions holds how an import file is processed. TimeZone applies to scheduled times without an offset.
+type ImportOptions struct {
+	DryRun   bool
+	TimeZone string
+}
+
+// importRow is one post of an import file, as written by the user
+type importRow struct {
+	Text        string `json:"text"`
+	Network     string `json:"network"`
+	ScheduledAt string `json:"scheduledAt"`
+	Link        string `json:"link"`
+	ImageURL    string `json:"imageUrl"`
+}
+
+type ImportService interface {
+	Import(ctx context.Context, user *models.User, format models.ImportFormat, data []byte, options ImportOptions) (*models.PostImport, error)
+	Get(ctx context.Context, userID, importID primitive.ObjectID) (*models.PostImport, error)
+	List(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.PostImport, error)
+}
+
+type importService struct {
+	importRepository repositories.PostImportRepository
+	draftRepository  repositories.DraftRepository
+}
+
+func NewImportService(importRepo repositories.PostImportRepository, draftRepo repositories.DraftRepository) ImportService {
+	return &importService{
+		importRepository: importRepo,
+		draftRepository:  draftRepo,
+	}
+}
+
+// Import validates every row of a CSV or JSON file of posts. Unless it is a dry run, the rows are then
+// stored in one batch: rows with a scheduled time become scheduled drafts and the others plain drafts.
+// The import is all or nothing, so when any row is invalid ErrImportHasInvalidRows is returned along
+// with the per-row results and nothing is stored.
+func (s *importService) Import(ctx context.Context, user *models.User, format models.ImportFormat, data []byte, options ImportOptions) (*models.PostImport, error) {
+	loc, err := loadTimeZone(options.TimeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []importRow
+	switch format {
+	case models.ImportFormatCSV:
+		rows, err = parseImportCSV(data)
+	case models.ImportFormatJSON:
+		rows, err = parseImportJSON(data)
+	default:
+		err = fmt.Errorf("%w: unsupported format %q", ErrInvalidImportFile, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, ErrImportEmpty
+	}
+	if len(rows) > postImportMaxRows {
+		return nil, ErrImportTooManyRows
+	}
+
+	now := time.Now().UTC()
+	result := &models.PostImport{
+		ID:        primitive.NewObjectID(),
+		UserID:    user.ID,
+		Format:    format,
+		DryRun:    options.DryRun,
+		TotalRows: len(rows),
+		Rows:      make([]models.ImportRowResult, len(rows)),
+		CreatedAt: now,
+	}
+	drafts := make([]models.Draft, 0, len(rows))
+	for i, row := range rows {
+		draft, errs, warnings := validateImportRow(user, row, loc, now)
+		rowResult := models.ImportRowResult{Row: i + 1, Valid: len(errs) == 0, Errors: errs, Warnings: warnings, Network: strings.ToLower(strings.TrimSpace(row.Network))}
+		if rowResult.Valid {
+			result.ValidRows++
+			rowResult.ScheduledAt = draft.ScheduledAt
+			draft.UserID = user.ID
+			draft.ImportID = result.ID
+			draft.Title = fmt.Sprintf("Import %s, row %d", now.Format("02/01/2006"), i+1)
+			draft.Version = 1
+			draft.CreatedAt = now
+			draft.UpdatedAt = now
+			drafts = append(drafts, draft)
+		}
+		result.Rows[i] = rowResult
+	}
+
+	if options.DryRun {
+		return result, nil
+	}
+	if result.ValidRows < result.TotalRows {
+		return result, ErrImportHasInvalidRows
+	}
+
+	ids, err := s.draftRepository.CreateMany(ctx, drafts)
+	if err != nil {
+		// A batch insert stops at the first failure, so the drafts stored before it are removed
+		s.discardDrafts(ctx, result.ID)
+		return nil, err
+	}
+	for i := range result.Rows {
+		result.Rows[i].DraftID = ids[i]
+		if drafts[i].Status == models.DraftStatusScheduled {
+			result.ScheduledPosts++
+		} else {
+			result.DraftsCreated++
+		}
+	}
+
+	if _, err := s.importRepository.Create(ctx, result); err != nil {
+		s.discardDrafts(ctx, result.ID)
+		return nil, err
+	}
+
+	log.Logger.Info("Posts imported",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("importId", result.ID.Hex()),
+		zap.String("format", string(format)),
+		zap.Int("drafts", result.DraftsCreated),
+		zap.Int("scheduled", result.ScheduledPosts),
+	)
+	return result, nil
+}
+
+// discardDrafts removes the drafts of an import that could not be completed, keeping it all or nothing
+func (s *importService) discardDrafts(ctx context.Context, importID primitive.ObjectID) {
+	if err := s.draftRepository.DeleteByImport(ctx, importID); err != nil {
+		log.Logger.Error("Failed to remove the drafts of a failed import", zap.String("importId", importID.Hex()), zap.Error(err))
+	}
+}
+
+func (s *importService) Get(ctx context.Context, userID, importID primitive.ObjectID) (*models.PostImport, error) {
+	postImport, err := s.importRepository.GetByID(ctx, importID)
+	if err != nil {
+		return nil, err
+	}
+	if postImport == nil || postImport.UserID != userID {
+		return nil, ErrImportNotFound
+	}
+	return postImport, nil
+}
+
+func (s *importService) List(ctx context.Context, userID primitive.ObjectID, limit int) ([]models.PostImport, error) {
+	imports, err := s.importRepository.ListByUser(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	if imports == nil {
+		imports = []models.PostImport{}
+	}
+	return imports, nil
+}
+
+// parseImportCSV reads a CSV file with a header row. Column names are matched ignoring case, spaces and
+// underscores, so "Scheduled At", "scheduled_at" and "scheduledAt" are the same column; unknown columns are ignored.
+func parseImportCSV(data []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, ErrImportEmpty
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.NewReplacer("_", "", " ", "", "-", "").Replace(strings.ToLower(strings.TrimSpace(name)))
+		columns[name] = i
+	}
+	if _, ok := columns["text"]; !ok {
+		return nil, fmt.Errorf("%w: missing text column", ErrInvalidImportFile)
+	}
+
+	cell := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return record[i]
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+		}
+		rows = append(rows, importRow{
+			Text:        cell(record, "text"),
+			Network:     cell(record, "network"),
+			ScheduledAt: cell(record, "scheduledat"),
+			Link:        cell(record, "link"),
+			ImageURL:    cell(record, "imageurl"),
+		})
+		if len(rows) > postImportMaxRows {
+			return nil, ErrImportTooManyRows
+		}
+	}
+	return rows, nil
+}
+
+// parseImportJSON reads a JSON array of posts
+func parseImportJSON(data []byte) ([]importRow, error) {
+	var rows []importRow
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImportFile, err)
+	}
+	return rows, nil
+}
+
+// validateImportRow turns a row into the draft it will be stored as, or returns every problem found in it.
+// Warnings report what of a valid row is left out of its draft.
+func validateImportRow(user *models.User, row importRow, loc *time.Location, now time.Time) (models.Draft, []string, []string) {
+	var errs, warnings []string
+	draft := models.Draft{Status: models.DraftStatusDraft}
+
+	text := strings.TrimSpace(row.Text)
+	if text == "" {
+		errs = append(errs, "text is required")
+	}
+
+	network := strings.ToLower(strings.TrimSpace(row.Network))
+	if network != models.NetworkLinkedIn {
+		errs = append(errs, "network must be one of: "+models.NetworkLinkedIn)
+	}
+
+	if link := strings.TrimSpace(row.Link); link != "" {
+		if !isHTTPURL(link) {
+			errs = append(errs, "link must be an http or https URL")
+		} else if !strings.Contains(text, link) {
+			text = strings.TrimSpace(text + "\n\n" + link)
+		}
+	}
+	if utf8.RuneCountInString(text) > linkedInCharacterLimit {
+		errs = append(errs, fmt.Sprintf("text with its link must have at most %d characters", linkedInCharacterLimit))
+	}
+	draft.Text = text
+
+	// Drafts have no images and no network is published with one, so the image is left out with a warning
+	if strings.TrimSpace(row.ImageURL) != "" {
+		warnings = append(warnings, "imageUrl was ignored: posts are published without images")
+	}
+
+	if scheduled := strings.TrimSpace(row.ScheduledAt); scheduled != "" {
+		at, err := parseImportTime(scheduled, loc)
+		switch {
+		case err != nil:
+			errs = append(errs, "scheduled time must be RFC 3339 or YYYY-MM-DD HH:MM")
+		case !at.After(now):
+			errs = append(errs, ErrScheduleInPast.Error())
+		case user.RequiresApproval():
+			// Standalone drafts never go through review, so they could not be published when due
+			errs = append(errs, "posts cannot be scheduled while the approval workflow is on")
+		default:
+			draft.Status = models.DraftStatusScheduled
+			draft.ScheduledAt = &at
+			draft.ScheduleTargets = []models.DraftTarget{{Network: network}}
+		}
+	}
+
+	return draft, errs, warnings
+}
+
+func parseImportTime(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	for _, layout := range importTimeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t.UTC(), nil
+		}
+	}
+	return time.Time{}, errors.New("unrecognized time format")
+}
+
+func isHTTPURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
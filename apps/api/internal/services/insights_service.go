@@ -0,0 +1,192 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var ErrInvalidTimeZone = errors.New("invalid time zone")
+
+const (
+	// bestTimesMinPosts is how many posts with metrics are needed before the history is trusted over the defaults
+	bestTimesMinPosts = 5
+	// bestTimesSampleSize is how many of the latest posts with metrics are ranked
+	bestTimesSampleSize = 500
+	bestTimesSlots      = 5
+	// bestTimesPriorWeight pulls slots with few posts towards the user's average engagement,
+	// so one lucky post does not make its hour the best slot
+	bestTimesPriorWeight = 2.0
+	// bestTimesMinLead keeps the next recommended slot far enough ahead to be scheduled
+	bestTimesMinLead = 5 * time.Minute
+)
+
+const (
+	BestTimesSourceHistory = "history"
+	BestTimesSourceDefault = "default"
+)
+
+// defaultBestTimes are the slots recommended until an account has enough history: mid-week business
+// mornings and lunch time, when LinkedIn feeds are most active
+var defaultBestTimes = []TimeSlot{
+	{Weekday: "tuesday", Hour: 9},
+	{Weekday: "wednesday", Hour: 12},
+	{Weekday: "thursday", Hour: 9},
+	{Weekday: "wednesday", Hour: 8},
+	{Weekday: "tuesday", Hour: 12},
+}
+
+// TimeSlot is a weekly posting slot, in the time zone of the response it belongs to
+type TimeSlot struct {
+	Weekday           string  `json:"weekday"`
+	Hour              int     `json:"hour"`
+	Posts             int     `json:"posts"`
+	AverageEngagement float64 `json:"averageEngagement"`
+	Score             float64 `json:"score"`
+}
+
+type BestTimesResponse struct {
+	TimeZone     string     `json:"timeZone"`
+	Source       string     `json:"source"` // history or default
+	SampledPosts int        `json:"sampledPosts"`
+	Slots        []TimeSlot `json:"slots"`
+	NextSlot     time.Time  `json:"nextSlot"`
+}
+
+type InsightsService interface {
+	BestTimes(ctx context.Context, userID primitive.ObjectID, timeZone string) (*BestTimesResponse, error)
+	NextRecommendedSlot(ctx context.Context, userID primitive.ObjectID, timeZone string) (time.Time, error)
+}
+
+type insightsService struct {
+	storiesRepository repositories.SocialPostStoriesRepository
+}
+
+func NewInsightsService(storiesRepo repositories.SocialPostStoriesRepository) InsightsService {
+	return &insightsService{storiesRepository: storiesRepo}
+}
+
+// BestTimes ranks the weekday/hour slots of the user's latest published posts by engagement, in timeZone.
+// Accounts with too little history get the default slots.
+func (s *insightsService) BestTimes(ctx context.Context, userID primitive.ObjectID, timeZone string) (*BestTimesResponse, error) {
+	loc, err := loadTimeZone(timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	stories, err := s.storiesRepository.ListMeasuredByUser(ctx, userID, bestTimesSampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	type bucket struct {
+		posts      int
+		engagement int
+	}
+	buckets := make(map[[2]int]*bucket)
+	sampled, total := 0, 0
+	for _, story := range stories {
+		if story.Status != models.StoryStatusSuccess || story.LatestMetrics == nil {
+			continue
+		}
+		published := story.CreatedAt.In(loc)
+		key := [2]int{int(published.Weekday()), published.Hour()}
+		b, ok := buckets[key]
+		if !ok {
+			b = &bucket{}
+			buckets[key] = b
+		}
+		b.posts++
+		b.engagement += story.LatestMetrics.Total()
+		sampled++
+		total += story.LatestMetrics.Total()
+	}
+
+	resp := &BestTimesResponse{TimeZone: loc.String(), SampledPosts: sampled}
+	if sampled < bestTimesMinPosts {
+		resp.Source = BestTimesSourceDefault
+		resp.Slots = append([]TimeSlot(nil), defaultBestTimes...)
+	} else {
+		resp.Source = BestTimesSourceHistory
+		mean := float64(total) / float64(sampled)
+		for key, b := range buckets {
+			resp.Slots = append(resp.Slots, TimeSlot{
+				Weekday:           strings.ToLower(time.Weekday(key[0]).String()),
+				Hour:              key[1],
+				Posts:             b.posts,
+				AverageEngagement: float64(b.engagement) / float64(b.posts),
+				Score:             (float64(b.engagement) + bestTimesPriorWeight*mean) / (float64(b.posts) + bestTimesPriorWeight),
+			})
+		}
+		sort.Slice(resp.Slots, func(i, j int) bool {
+			if resp.Slots[i].Score != resp.Slots[j].Score {
+				return resp.Slots[i].Score > resp.Slots[j].Score
+			}
+			return resp.Slots[i].Posts > resp.Slots[j].Posts
+		})
+		if len(resp.Slots) > bestTimesSlots {
+			resp.Slots = resp.Slots[:bestTimesSlots]
+		}
+	}
+
+	resp.NextSlot = nextSlot(resp.Slots, time.Now().In(loc).Add(bestTimesMinLead))
+	return resp, nil
+}
+
+// NextRecommendedSlot returns the earliest upcoming occurrence of one of the user's recommended slots
+func (s *insightsService) NextRecommendedSlot(ctx context.Context, userID primitive.ObjectID, timeZone string) (time.Time, error) {
+	resp, err := s.BestTimes(ctx, userID, timeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return resp.NextSlot, nil
+}
+
+// nextSlot returns the earliest start of one of slots that is after after, in after's location
+func nextSlot(slots []TimeSlot, after time.Time) time.Time {
+	var next time.Time
+	for _, slot := range slots {
+		weekday := parseWeekday(slot.Weekday)
+		for days := 0; days <= 7; days++ {
+			day := after.AddDate(0, 0, days)
+			if day.Weekday() != weekday {
+				continue
+			}
+			at := time.Date(day.Year(), day.Month(), day.Day(), slot.Hour, 0, 0, 0, after.Location())
+			if at.After(after) {
+				if next.IsZero() || at.Before(next) {
+					next = at
+				}
+				break
+			}
+		}
+	}
+	return next.UTC()
+}
+
+func parseWeekday(name string) time.Weekday {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), name) {
+			return d
+		}
+	}
+	return time.Sunday
+}
+
+// loadTimeZone resolves an IANA time zone name, defaulting to UTC
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, ErrInvalidTimeZone
+	}
+	return loc, nil
+}
@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+// withJobLease runs fn while holding the named lease, so that only one instance at a time runs it. When another
+// instance holds the lease, fn is skipped and nil is returned. fn is given no longer than the lease, so a run
+// never outlives it.
+func withJobLease(ctx context.Context, leases repositories.JobLeaseRepository, name string, lease time.Duration, fn func(ctx context.Context) error) error {
+	owner := primitive.NewObjectID().Hex()
+	held, err := leases.Acquire(ctx, name, owner, time.Now().UTC(), lease)
+	if err != nil {
+		return err
+	}
+	if !held {
+		log.Logger.Debug("Job already running on another instance", zap.String("job", name))
+		return nil
+	}
+	defer func() {
+		_ = leases.Release(context.WithoutCancel(ctx), name, owner)
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, lease)
+	defer cancel()
+	return fn(ctx)
+}
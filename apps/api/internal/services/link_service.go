@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/config"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+)
+
+var ErrShortLinkNotFound = errors.New("short link not found")
+
+const (
+	shortLinkCodeLength   = 7
+	shortLinkCodeAttempts = 5
+	shortLinkAlphabet     = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+)
+
+type LinkService interface {
+	Track(ctx context.Context, userID primitive.ObjectID, network, campaign string, texts []string) ([]string, []primitive.ObjectID)
+	AttachStory(ctx context.Context, linkIDs []primitive.ObjectID, storyID primitive.ObjectID)
+	Resolve(ctx context.Context, code, referrer, userAgent string) (string, error)
+}
+
+type linkService struct {
+	linkRepository  repositories.ShortLinkRepository
+	clickRepository repositories.LinkClickRepository
+	cfg             config.LinksConfig
+}
+
+func NewLinkService(linkRepo repositories.ShortLinkRepository, clickRepo repositories.LinkClickRepository) LinkService {
+	return &linkService{
+		linkRepository:  linkRepo,
+		clickRepository: clickRepo,
+		cfg:             config.Get().Links,
+	}
+}
+
+// Track rewrites the links of texts before they are published to network: UTM parameters are added
+// and, with the shortener enabled, each link is replaced by a short link that counts clicks. The same
+// link in several texts gets a single short link. It returns the rewritten texts and the IDs of the
+// short links created, to be attached to the story once it is published. Links that cannot be
+// shortened are published with their UTM parameters only.
+func (s *linkService) Track(ctx context.Context, userID primitive.ObjectID, network, campaign string, texts []string) ([]string, []primitive.ObjectID) {
+	if !s.cfg.UTMEnabled && !s.cfg.ShortenerEnabled {
+		return texts, nil
+	}
+	if campaign == "" {
+		campaign = s.cfg.UTMCampaign
+	}
+
+	rewritten := make(map[string]string)
+	var linkIDs []primitive.ObjectID
+	result := make([]string, len(texts))
+	for i, text := range texts {
+		links := findLinks(text)
+		var b strings.Builder
+		last := 0
+		for _, loc := range links {
+			original := text[loc[0]:loc[1]]
+			replacement, ok := rewritten[original]
+			if !ok {
+				replacement = original
+				if !s.isShortLink(original) {
+					replacement = s.rewrite(ctx, userID, network, campaign, original, &linkIDs)
+				}
+				rewritten[original] = replacement
+			}
+			b.WriteString(text[last:loc[0]])
+			b.WriteString(replacement)
+			last = loc[1]
+		}
+		b.WriteString(text[last:])
+		result[i] = b.String()
+	}
+	return result, linkIDs
+}
+
+func (s *linkService) rewrite(ctx context.Context, userID primitive.ObjectID, network, campaign, link string, linkIDs *[]primitive.ObjectID) string {
+	target := link
+	if s.cfg.UTMEnabled {
+		target = addUTMParameters(link, network, s.cfg.UTMMedium, campaign)
+	}
+	if !s.cfg.ShortenerEnabled {
+		return target
+	}
+
+	short, err := s.shorten(ctx, userID, network, campaign, target)
+	if err != nil {
+		log.Logger.Warn("Failed to create short link", zap.String("userId", userID.Hex()), zap.String("url", target), zap.Error(err))
+		return target
+	}
+	*linkIDs = append(*linkIDs, short.ID)
+	return short.ShortURL
+}
+
+// shorten stores a short link for target, drawing a new code when the random one is already taken
+func (s *linkService) shorten(ctx context.Context, userID primitive.ObjectID, network, campaign, target string) (*models.ShortLink, error) {
+	var err error
+	for attempt := 0; attempt < shortLinkCodeAttempts; attempt++ {
+		var code string
+		code, err = newShortLinkCode()
+		if err != nil {
+			return nil, err
+		}
+		link := &models.ShortLink{
+			Code:      code,
+			UserID:    userID,
+			Network:   network,
+			Campaign:  campaign,
+			TargetURL: target,
+			ShortURL:  strings.TrimRight(s.cfg.ShortLinkBaseURL, "/") + "/" + code,
+			CreatedAt: time.Now().UTC(),
+		}
+		link.ID, err = s.linkRepository.Create(ctx, link)
+		if err == nil {
+			return link, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+func (s *linkService) isShortLink(link string) bool {
+	base := strings.TrimRight(s.cfg.ShortLinkBaseURL, "/")
+	return base != "" && strings.HasPrefix(link, base+"/")
+}
+
+// AttachStory records which story the short links were published in, so clicks count towards it
+func (s *linkService) AttachStory(ctx context.Context, linkIDs []primitive.ObjectID, storyID primitive.ObjectID) {
+	if len(linkIDs) == 0 || storyID.IsZero() {
+		return
+	}
+	if err := s.linkRepository.AttachStory(ctx, linkIDs, storyID); err != nil {
+		log.Logger.Warn("Short link clicks will not be attributed to the story", zap.String("storyId", storyID.Hex()), zap.Error(err))
+	}
+}
+
+// Resolve returns the target of a short link and records the click. A click that cannot be
+// recorded is logged; the visitor is redirected anyway.
+func (s *linkService) Resolve(ctx context.Context, code, referrer, userAgent string) (string, error) {
+	link, err := s.linkRepository.GetByCode(ctx, code)
+	if err != nil {
+		return "", err
+	}
+	if link == nil {
+		return "", ErrShortLinkNotFound
+	}
+
+	now := time.Now().UTC()
+	click := &models.LinkClick{
+		LinkID:    link.ID,
+		StoryID:   link.StoryID,
+		Referrer:  referrer,
+		UserAgent: userAgent,
+		ClickedAt: now,
+	}
+	if _, err := s.clickRepository.Create(ctx, click); err == nil {
+		_ = s.linkRepository.IncrementClicks(ctx, link.ID, now)
+	}
+	return link.TargetURL, nil
+}
+
+// addUTMParameters appends utm_source, utm_medium and utm_campaign to link, keeping any UTM
+// parameter it already has. The rest of the link is left as written, since re-encoding its query
+// can break links that rely on the order or encoding of their parameters. Links that cannot be
+// parsed are returned unchanged.
+func addUTMParameters(link, source, medium, campaign string) string {
+	u, err := url.Parse(link)
+	if err != nil || u.Host == "" {
+		return link
+	}
+	query := u.Query()
+	params := [][2]string{{"utm_source", source}, {"utm_medium", medium}, {"utm_campaign", campaign}}
+	var added []string
+	for _, p := range params {
+		if p[1] == "" || query.Has(p[0]) {
+			continue
+		}
+		added = append(added, p[0]+"="+url.QueryEscape(p[1]))
+	}
+	if len(added) == 0 {
+		return link
+	}
+
+	base, fragment, hasFragment := strings.Cut(link, "#")
+	separator := "&"
+	switch {
+	case !strings.Contains(base, "?"):
+		separator = "?"
+	case strings.HasSuffix(base, "?"), strings.HasSuffix(base, "&"):
+		separator = ""
+	}
+	tagged := base + separator + strings.Join(added, "&")
+	if hasFragment {
+		tagged += "#" + fragment
+	}
+	return tagged
+}
+
+func newShortLinkCode() (string, error) {
+	alphabetSize := big.NewInt(int64(len(shortLinkAlphabet)))
+	code := make([]byte, shortLinkCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		code[i] = shortLinkAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// findLinks returns the byte ranges of the http(s) links of text. Trailing punctuation usually
+// ends the sentence, not the URL, so it is left out.
+func findLinks(text string) [][2]int {
+	var links [][2]int
+	for _, m := range previewURLRegex.FindAllStringIndex(text, -1) {
+		end := m[1]
+		for end > m[0] && strings.ContainsRune(".,;:!?)]'", rune(text[end-1])) {
+			end--
+		}
+		links = append(links, [2]int{m[0], end})
+	}
+	return links
+}
@@ -0,0 +1,569 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/config"
+	"github.com/postpilot/api/internal/httpclient"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+const (
+	linkedInRestBaseURL = "https://api.linkedin.com/rest"
+	linkedInTokenURL    = "https://www.linkedin.com/oauth/v2/accessToken"
+	linkedInUserInfoURL = "https://api.linkedin.com/v2/userinfo"
+)
+
+var ErrLinkedInUnauthorized = errors.New("LinkedIn token expired or invalid. Please reconnect your LinkedIn account")
+
+// LinkedInClient wraps calls to the versioned LinkedIn REST API
+type LinkedInClient struct {
+	httpClient   *http.Client
+	apiVersion   string
+	clientID     string
+	clientSecret string
+}
+
+// NewLinkedInClient creates a LinkedIn client using the shared HTTP client
+func NewLinkedInClient(client *httpclient.HTTPClient) *LinkedInClient {
+	cfg := config.Get().LinkedIn
+	return &LinkedInClient{
+		httpClient:   client.Client(),
+		apiVersion:   cfg.APIVersion,
+		clientID:     cfg.ClientID,
+		clientSecret: cfg.ClientSecret,
+	}
+}
+
+// LinkedInToken is an OAuth token pair issued by LinkedIn
+type LinkedInToken struct {
+	AccessToken           string `json:"access_token"`
+	ExpiresIn             int    `json:"expires_in"`
+	RefreshToken          string `json:"refresh_token"`
+	RefreshTokenExpiresIn int    `json:"refresh_token_expires_in"`
+}
+
+// RefreshAccessToken exchanges a refresh token for a new access token
+func (c *LinkedInClient) RefreshAccessToken(ctx context.Context, refreshToken string) (*LinkedInToken, error) {
+	data := url.Values{}
+	data.Set("grant_type", "refresh_token")
+	data.Set("refresh_token", refreshToken)
+	data.Set("client_id", c.clientID)
+	data.Set("client_secret", c.clientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, linkedInTokenURL, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn token refresh request failed", zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// LinkedIn answers 400 for revoked or expired refresh tokens
+	if resp.StatusCode == http.StatusBadRequest {
+		respBody, _ := io.ReadAll(resp.Body)
+		log.Logger.Warn("LinkedIn refresh token rejected", zap.String("response", string(respBody)))
+		return nil, ErrLinkedInUnauthorized
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, linkedInResponseError(resp, "refresh token")
+	}
+
+	var token LinkedInToken
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, fmt.Errorf("failed to decode token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("LinkedIn returned an empty access token")
+	}
+	return &token, nil
+}
+
+// ValidateToken checks that an access token is still accepted by LinkedIn
+func (c *LinkedInClient) ValidateToken(ctx context.Context, accessToken string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, linkedInUserInfoURL, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn userinfo request failed", zap.Error(err))
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return linkedInResponseError(resp, "userinfo")
+	}
+	return nil
+}
+
+// UpdatePostCommentary replaces the text of a published post using a partial update
+func (c *LinkedInClient) UpdatePostCommentary(ctx context.Context, accessToken, postURN, commentary string) error {
+	payload := map[string]interface{}{
+		"patch": map[string]interface{}{
+			"$set": map[string]interface{}{
+				"commentary": formatLittleText(commentary),
+			},
+		},
+	}
+
+	req, err := c.newRestRequest(ctx, http.MethodPost, "/posts/"+url.PathEscape(postURN), accessToken, payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-RestLi-Method", "PARTIAL_UPDATE")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn partial update request failed", zap.String("postUrn", postURN), zap.Error(err))
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return linkedInResponseError(resp, "partial update")
+	}
+	return nil
+}
+
+// newPostPayload builds the body of a versioned Posts API request for a public text post
+func newPostPayload(authorURN, text string) map[string]interface{} {
+	return map[string]interface{}{
+		"author":     authorURN,
+		"commentary": formatLittleText(text),
+		"visibility": "PUBLIC",
+		"distribution": map[string]interface{}{
+			"feedDistribution":               "MAIN_FEED",
+			"targetEntities":                 []interface{}{},
+			"thirdPartyDistributionChannels": []interface{}{},
+		},
+		"lifecycleState":            "PUBLISHED",
+		"isReshareDisabledByAuthor": false,
+	}
+}
+
+// newDocumentPostPayload builds a Posts API request for a document post, shown by LinkedIn as a carousel
+func newDocumentPostPayload(authorURN, text, documentURN, title string) map[string]interface{} {
+	payload := newPostPayload(authorURN, text)
+	payload["content"] = map[string]interface{}{
+		"media": map[string]interface{}{
+			"title": title,
+			"id":    documentURN,
+		},
+	}
+	return payload
+}
+
+// UploadDocument uploads a PDF owned by ownerURN through the Documents API and returns the document URN
+func (c *LinkedInClient) UploadDocument(ctx context.Context, accessToken, ownerURN string, pdf []byte) (string, error) {
+	payload := map[string]interface{}{
+		"initializeUploadRequest": map[string]interface{}{"owner": ownerURN},
+	}
+	req, err := c.newRestRequest(ctx, http.MethodPost, "/documents?action=initializeUpload", accessToken, payload)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn document upload initialization failed", zap.Error(err))
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", linkedInResponseError(resp, "initialize document upload")
+	}
+
+	var result struct {
+		Value struct {
+			UploadURL string `json:"uploadUrl"`
+			Document  string `json:"document"`
+		} `json:"value"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+	if result.Value.UploadURL == "" || result.Value.Document == "" {
+		return "", errors.New("LinkedIn did not return a document upload URL")
+	}
+
+	upload, err := http.NewRequestWithContext(ctx, http.MethodPut, result.Value.UploadURL, bytes.NewReader(pdf))
+	if err != nil {
+		return "", err
+	}
+	upload.Header.Set("Authorization", "Bearer "+accessToken)
+	upload.Header.Set("Content-Type", "application/pdf")
+
+	uploadResp, err := c.httpClient.Do(upload)
+	if err != nil {
+		log.Logger.Error("LinkedIn document upload failed", zap.String("document", result.Value.Document), zap.Error(err))
+		return "", err
+	}
+	defer uploadResp.Body.Close()
+
+	if uploadResp.StatusCode != http.StatusOK && uploadResp.StatusCode != http.StatusCreated {
+		return "", linkedInResponseError(uploadResp, "upload document")
+	}
+	return result.Value.Document, nil
+}
+
+// CreatePost publishes a post built by newPostPayload and returns its URN
+func (c *LinkedInClient) CreatePost(ctx context.Context, accessToken string, payload map[string]interface{}) (string, error) {
+	req, err := c.newRestRequest(ctx, http.MethodPost, "/posts", accessToken, payload)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn create post request failed", zap.Error(err))
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return "", linkedInResponseError(resp, "create post")
+	}
+
+	postURN := resp.Header.Get("x-restli-id")
+	if postURN == "" {
+		return "", errors.New("LinkedIn did not return the post URN")
+	}
+	return postURN, nil
+}
+
+// DeletePost deletes a post by its URN. Both share and ugcPost URNs are accepted,
+// so posts published through the legacy API can be deleted here as well.
+func (c *LinkedInClient) DeletePost(ctx context.Context, accessToken, postURN string) error {
+	req, err := c.newRestRequest(ctx, http.MethodDelete, "/posts/"+url.PathEscape(postURN), accessToken, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-RestLi-Method", "DELETE")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn delete request failed", zap.String("postUrn", postURN), zap.Error(err))
+		return err
+	}
+	defer resp.Body.Close()
+
+	// A post that is already gone counts as deleted
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return linkedInResponseError(resp, "delete post")
+	}
+	return nil
+}
+
+// GetSocialActions returns the reaction and comment counts of a post
+func (c *LinkedInClient) GetSocialActions(ctx context.Context, accessToken, postURN string) (*models.EngagementMetrics, error) {
+	req, err := c.newRestRequest(ctx, http.MethodGet, "/socialActions/"+url.PathEscape(postURN), accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn socialActions request failed", zap.String("postUrn", postURN), zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, linkedInResponseError(resp, "socialActions")
+	}
+
+	var result struct {
+		LikesSummary struct {
+			TotalLikes int `json:"totalLikes"`
+		} `json:"likesSummary"`
+		CommentsSummary struct {
+			AggregatedTotalComments int `json:"aggregatedTotalComments"`
+		} `json:"commentsSummary"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode socialActions response: %w", err)
+	}
+
+	// socialActions has no share count; it is left out rather than reported as zero
+	return &models.EngagementMetrics{
+		Reactions: result.LikesSummary.TotalLikes,
+		Comments:  result.CommentsSummary.AggregatedTotalComments,
+	}, nil
+}
+
+// GetOrganizationShareStatistics returns the lifetime statistics of a post authored by an organization page
+func (c *LinkedInClient) GetOrganizationShareStatistics(ctx context.Context, accessToken, organizationURN, postURN string) (*models.EngagementMetrics, error) {
+	postParam := "shares"
+	if strings.HasPrefix(postURN, "urn:li:ugcPost:") {
+		postParam = "ugcPosts"
+	}
+	// Rest.li list syntax must keep its parentheses unescaped, so the query is built by hand
+	query := fmt.Sprintf("?q=organizationalEntity&organizationalEntity=%s&%s=List(%s)",
+		url.QueryEscape(organizationURN), postParam, url.QueryEscape(postURN))
+
+	req, err := c.newRestRequest(ctx, http.MethodGet, "/organizationalEntityShareStatistics"+query, accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn share statistics request failed", zap.String("postUrn", postURN), zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, linkedInResponseError(resp, "organizationalEntityShareStatistics")
+	}
+
+	var result struct {
+		Elements []struct {
+			TotalShareStatistics struct {
+				ShareCount      int `json:"shareCount"`
+				LikeCount       int `json:"likeCount"`
+				CommentCount    int `json:"commentCount"`
+				ImpressionCount int `json:"impressionCount"`
+			} `json:"totalShareStatistics"`
+		} `json:"elements"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode share statistics response: %w", err)
+	}
+	if len(result.Elements) == 0 {
+		return &models.EngagementMetrics{}, nil
+	}
+
+	stats := result.Elements[0].TotalShareStatistics
+	return &models.EngagementMetrics{
+		Reactions:   stats.LikeCount,
+		Comments:    stats.CommentCount,
+		Shares:      &stats.ShareCount,
+		Impressions: stats.ImpressionCount,
+	}, nil
+}
+
+// LinkedInComment is a comment returned by the LinkedIn socialActions API
+type LinkedInComment struct {
+	CommentURN string
+	Actor      string
+	Text       string
+	CreatedAt  time.Time
+	ParentURN  string
+}
+
+const (
+	// linkedInCommentsPageSize is the largest page of comments LinkedIn returns
+	linkedInCommentsPageSize = 100
+	// linkedInCommentsMaxPages bounds the comments read from a single post
+	linkedInCommentsMaxPages = 20
+)
+
+// ListComments returns the comments of a post, reading up to linkedInCommentsMaxPages pages
+func (c *LinkedInClient) ListComments(ctx context.Context, accessToken, postURN string) ([]LinkedInComment, error) {
+	var comments []LinkedInComment
+	for page := 0; page < linkedInCommentsMaxPages; page++ {
+		elements, err := c.listCommentsPage(ctx, accessToken, postURN, page*linkedInCommentsPageSize)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, elements...)
+		if len(elements) < linkedInCommentsPageSize {
+			return comments, nil
+		}
+	}
+	log.Logger.Warn("Stopped reading post comments at the page limit", zap.String("postUrn", postURN), zap.Int("count", len(comments)))
+	return comments, nil
+}
+
+func (c *LinkedInClient) listCommentsPage(ctx context.Context, accessToken, postURN string, start int) ([]LinkedInComment, error) {
+	endpoint := fmt.Sprintf("/socialActions/%s/comments?start=%d&count=%d", url.PathEscape(postURN), start, linkedInCommentsPageSize)
+	req, err := c.newRestRequest(ctx, http.MethodGet, endpoint, accessToken, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn comments request failed", zap.String("postUrn", postURN), zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, linkedInResponseError(resp, "list comments")
+	}
+
+	var result struct {
+		Elements []struct {
+			CommentURN string `json:"commentUrn"`
+			Actor      string `json:"actor"`
+			Message    struct {
+				Text string `json:"text"`
+			} `json:"message"`
+			Created struct {
+				Time int64 `json:"time"`
+			} `json:"created"`
+			ParentComment string `json:"parentComment"`
+		} `json:"elements"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode comments response: %w", err)
+	}
+
+	comments := make([]LinkedInComment, 0, len(result.Elements))
+	for _, el := range result.Elements {
+		comments = append(comments, LinkedInComment{
+			CommentURN: el.CommentURN,
+			Actor:      el.Actor,
+			Text:       el.Message.Text,
+			CreatedAt:  time.UnixMilli(el.Created.Time).UTC(),
+			ParentURN:  el.ParentComment,
+		})
+	}
+	return comments, nil
+}
+
+// CreateComment posts a comment on a post, as a reply when parentCommentURN is set, and returns the new comment URN
+func (c *LinkedInClient) CreateComment(ctx context.Context, accessToken, actorURN, postURN, parentCommentURN, text string) (string, error) {
+	payload := map[string]interface{}{
+		"actor":   actorURN,
+		"object":  postURN,
+		"message": map[string]interface{}{"text": text},
+	}
+	if parentCommentURN != "" {
+		payload["parentComment"] = parentCommentURN
+	}
+
+	req, err := c.newRestRequest(ctx, http.MethodPost, "/socialActions/"+url.PathEscape(postURN)+"/comments", accessToken, payload)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("LinkedIn create comment request failed", zap.String("postUrn", postURN), zap.Error(err))
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		return "", linkedInResponseError(resp, "create comment")
+	}
+
+	var result struct {
+		CommentURN string `json:"commentUrn"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&result)
+	if result.CommentURN == "" {
+		result.CommentURN = resp.Header.Get("x-restli-id")
+	}
+	return result.CommentURN, nil
+}
+
+func (c *LinkedInClient) newRestRequest(ctx context.Context, method, path, accessToken string, payload interface{}) (*http.Request, error) {
+	var body io.Reader
+	if payload != nil {
+		b, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewBuffer(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, linkedInRestBaseURL+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("LinkedIn-Version", c.apiVersion)
+	req.Header.Set("X-Restli-Protocol-Version", "2.0.0")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// linkedInResponseError converts a non-success LinkedIn response into an error
+func linkedInResponseError(resp *http.Response, operation string) error {
+	respBody, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		log.Logger.Warn("LinkedIn token expired or invalid",
+			zap.String("operation", operation),
+			zap.Int("statusCode", resp.StatusCode),
+		)
+		return ErrLinkedInUnauthorized
+	}
+	log.Logger.Error("LinkedIn API error",
+		zap.String("operation", operation),
+		zap.Int("statusCode", resp.StatusCode),
+		zap.String("response", string(respBody)),
+	)
+	return fmt.Errorf("LinkedIn API error (%d): %s", resp.StatusCode, string(respBody))
+}
+
+// littleTextReplacer escapes the characters reserved by LinkedIn's "little text" format,
+// which the REST API uses for commentary. Unescaped, they are parsed as markup or rejected.
+var littleTextReplacer = strings.NewReplacer(
+	`\`, `\\`,
+	`|`, `\|`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`@`, `\@`,
+	`[`, `\[`,
+	`]`, `\]`,
+	`(`, `\(`,
+	`)`, `\)`,
+	`<`, `\<`,
+	`>`, `\>`,
+	`#`, `\#`,
+	`*`, `\*`,
+	`_`, `\_`,
+	`~`, `\~`,
+)
+
+func escapeLittleText(text string) string {
+	return littleTextReplacer.Replace(text)
+}
+
+var hashtagRegex = regexp.MustCompile(`(^|[^\p{L}\p{N}_&])#([\p{L}\p{N}_]+)`)
+
+// formatLittleText escapes text for the commentary field, turning #hashtags into
+// hashtag templates so they stay clickable instead of being posted as plain text
+func formatLittleText(text string) string {
+	var b strings.Builder
+	last := 0
+	for _, m := range hashtagRegex.FindAllStringSubmatchIndex(text, -1) {
+		tagStart, tagEnd := m[4], m[5]
+		hashPos := tagStart - 1
+		b.WriteString(escapeLittleText(text[last:hashPos]))
+		b.WriteString(`{hashtag|\#|`)
+		b.WriteString(escapeLittleText(text[tagStart:tagEnd]))
+		b.WriteString(`}`)
+		last = tagEnd
+	}
+	b.WriteString(escapeLittleText(text[last:]))
+	return b.String()
+}
@@ -0,0 +1,190 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/postpilot/api/internal/config"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+const (
+	// linkedInConnectionsPageSize is how many users are read at a time by the connection check
+	linkedInConnectionsPageSize = 100
+	// linkedInConnectionsLease bounds a run of the connection check, which one instance at a time performs
+	linkedInConnectionsLease = 30 * time.Minute
+)
+
+type LinkedInConnectionService interface {
+	CheckConnections(ctx context.Context) error
+	// Reauthorize handles a token LinkedIn rejected: it returns a refreshed access token or, when the
+	// token cannot be refreshed, marks the connection as needs_reconnect and returns ErrLinkedInUnauthorized
+	Reauthorize(ctx context.Context, user *models.User) (string, error)
+}
+
+type linkedInConnectionService struct {
+	userRepository  repositories.UserRepository
+	leaseRepository repositories.JobLeaseRepository
+	linkedInClient  *LinkedInClient
+	webhookService  WebhookService
+	refreshWindow   time.Duration
+}
+
+func NewLinkedInConnectionService(userRepo repositories.UserRepository, leaseRepo repositories.JobLeaseRepository, linkedInClient *LinkedInClient, webhookService WebhookService) LinkedInConnectionService {
+	return &linkedInConnectionService{
+		userRepository:  userRepo,
+		leaseRepository: leaseRepo,
+		linkedInClient:  linkedInClient,
+		webhookService:  webhookService,
+		refreshWindow:   config.Get().Jobs.LinkedInRefreshWindow,
+	}
+}
+
+// CheckConnections refreshes LinkedIn tokens that are about to expire and validates the rest,
+// marking connections that can no longer publish as needs_reconnect. One instance at a time runs it.
+func (s *linkedInConnectionService) CheckConnections(ctx context.Context) error {
+	return withJobLease(ctx, s.leaseRepository, "linkedin-connections", linkedInConnectionsLease, s.checkConnections)
+}
+
+func (s *linkedInConnectionService) checkConnections(ctx context.Context) error {
+	var checked, refreshed, healthy, needsReconnect int
+	after := primitive.NilObjectID
+	for {
+		users, err := s.userRepository.ListWithLinkedInToken(ctx, after, linkedInConnectionsPageSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range users {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			status, didRefresh, err := s.checkConnection(ctx, &users[i])
+			if err != nil {
+				log.Logger.Warn("Failed to check LinkedIn connection", zap.String("userId", users[i].ID.Hex()), zap.Error(err))
+				continue
+			}
+			switch {
+			case didRefresh:
+				refreshed++
+			case status == models.LinkedInNeedsReconnect:
+				needsReconnect++
+			default:
+				healthy++
+			}
+		}
+
+		checked += len(users)
+		if len(users) < linkedInConnectionsPageSize {
+			break
+		}
+		after = users[len(users)-1].ID
+	}
+
+	log.Logger.Info("LinkedIn connections checked",
+		zap.Int("users", checked),
+		zap.Int("refreshed", refreshed),
+		zap.Int("healthy", healthy),
+		zap.Int("needsReconnect", needsReconnect),
+	)
+	return nil
+}
+
+func (s *linkedInConnectionService) Reauthorize(ctx context.Context, user *models.User) (string, error) {
+	now := time.Now().UTC()
+	if user.CanRefreshLinkedInToken(now) {
+		accessToken, err := s.refreshToken(ctx, user, now)
+		if err == nil {
+			return accessToken, nil
+		}
+		if !errors.Is(err, ErrLinkedInUnauthorized) {
+			return "", err
+		}
+	}
+	if _, _, err := s.markNeedsReconnect(ctx, user, now, "access token rejected on publish"); err != nil {
+		return "", err
+	}
+	return "", ErrLinkedInUnauthorized
+}
+
+// checkConnection returns the resulting connection status and whether a new token was stored
+func (s *linkedInConnectionService) checkConnection(ctx context.Context, user *models.User) (models.LinkedInConnectionStatus, bool, error) {
+	now := time.Now().UTC()
+
+	if user.LinkedinTokenExpiresAt != nil && user.LinkedinTokenExpiresAt.Sub(now) <= s.refreshWindow && user.CanRefreshLinkedInToken(now) {
+		_, err := s.refreshToken(ctx, user, now)
+		if err == nil {
+			return models.LinkedInConnected, true, nil
+		}
+		if !errors.Is(err, ErrLinkedInUnauthorized) {
+			return "", false, err
+		}
+		return s.markNeedsReconnect(ctx, user, now, "refresh token rejected")
+	}
+
+	if user.LinkedinTokenExpiresAt != nil && !now.Before(*user.LinkedinTokenExpiresAt) {
+		return s.markNeedsReconnect(ctx, user, now, "access token expired")
+	}
+
+	if err := s.linkedInClient.ValidateToken(ctx, user.LinkedinAccessToken); err != nil {
+		if !errors.Is(err, ErrLinkedInUnauthorized) {
+			return "", false, err
+		}
+		return s.markNeedsReconnect(ctx, user, now, "access token rejected")
+	}
+
+	if err := s.userRepository.SetLinkedInConnectionStatus(ctx, user.ID, models.LinkedInConnected, now); err != nil {
+		return "", false, err
+	}
+	return models.LinkedInConnected, false, nil
+}
+
+// refreshToken stores a new access token obtained with the user's refresh token and returns it
+func (s *linkedInConnectionService) refreshToken(ctx context.Context, user *models.User, now time.Time) (string, error) {
+	token, err := s.linkedInClient.RefreshAccessToken(ctx, user.LinkedinRefreshToken)
+	if err != nil {
+		return "", err
+	}
+
+	var expiresAt, refreshExpiresAt *time.Time
+	if token.ExpiresIn > 0 {
+		t := now.Add(time.Duration(token.ExpiresIn) * time.Second)
+		expiresAt = &t
+	}
+	if token.RefreshTokenExpiresIn > 0 {
+		t := now.Add(time.Duration(token.RefreshTokenExpiresIn) * time.Second)
+		refreshExpiresAt = &t
+	}
+	if err := s.userRepository.SaveLinkedInToken(ctx, user.ID, token.AccessToken, token.RefreshToken, expiresAt, refreshExpiresAt); err != nil {
+		return "", err
+	}
+
+	log.Logger.Info("LinkedIn token refreshed",
+		zap.String("userId", user.ID.Hex()),
+		zap.Timep("expiresAt", expiresAt),
+	)
+	return token.AccessToken, nil
+}
+
+func (s *linkedInConnectionService) markNeedsReconnect(ctx context.Context, user *models.User, now time.Time, reason string) (models.LinkedInConnectionStatus, bool, error) {
+	if err := s.userRepository.SetLinkedInConnectionStatus(ctx, user.ID, models.LinkedInNeedsReconnect, now); err != nil {
+		return "", false, err
+	}
+	if user.LinkedinConnectionStatus != models.LinkedInNeedsReconnect {
+		log.Logger.Warn("LinkedIn connection needs reconnect",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("reason", reason),
+		)
+		s.webhookService.Dispatch(ctx, user.ID, models.WebhookLinkedInConnectionExpired, map[string]interface{}{
+			"reason":    reason,
+			"checkedAt": now,
+		})
+	}
+	return models.LinkedInNeedsReconnect, false, nil
+}
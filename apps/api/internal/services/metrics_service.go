@@ -0,0 +1,299 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/config"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+const metricsSummaryTopPosts = 5
+
+// metricsSyncLease bounds a run of the metrics sync, which one instance at a time performs
+const metricsSyncLease = 10 * time.Minute
+
+type MetricsService interface {
+	SyncDueMetrics(ctx context.Context) error
+	GetStoryMetrics(ctx context.Context, userID, storyID primitive.ObjectID) (*StoryMetricsResponse, error)
+	GetUserSummary(ctx context.Context, userID primitive.ObjectID) (*MetricsSummary, error)
+}
+
+type metricsService struct {
+	storiesRepository repositories.SocialPostStoriesRepository
+	metricsRepository repositories.PostMetricsRepository
+	userRepository    repositories.UserRepository
+	linkRepository    repositories.ShortLinkRepository
+	leaseRepository   repositories.JobLeaseRepository
+	linkedInClient    *LinkedInClient
+	batchSize         int
+}
+
+func NewMetricsService(
+	storiesRepo repositories.SocialPostStoriesRepository,
+	metricsRepo repositories.PostMetricsRepository,
+	userRepo repositories.UserRepository,
+	linkRepo repositories.ShortLinkRepository,
+	leaseRepo repositories.JobLeaseRepository,
+	linkedInClient *LinkedInClient,
+) MetricsService {
+	return &metricsService{
+		storiesRepository: storiesRepo,
+		metricsRepository: metricsRepo,
+		userRepository:    userRepo,
+		linkRepository:    linkRepo,
+		leaseRepository:   leaseRepo,
+		linkedInClient:    linkedInClient,
+		batchSize:         config.Get().Jobs.MetricsSyncBatchSize,
+	}
+}
+
+type StoryMetricsResponse struct {
+	StoryID         string                       `json:"storyId"`
+	Network         string                       `json:"network"`
+	ExternalPostID  string                       `json:"externalPostId"`
+	Latest          *models.EngagementMetrics    `json:"latest,omitempty"`
+	MetricsSyncedAt *time.Time                   `json:"metricsSyncedAt,omitempty"`
+	Series          []models.PostMetricsSnapshot `json:"series"`
+	Clicks          int64                        `json:"clicks"`
+	Links           []models.ShortLink           `json:"links"`
+}
+
+type PostEngagement struct {
+	StoryID     string                   `json:"storyId"`
+	Network     string                   `json:"network"`
+	PostContent string                   `json:"postContent"`
+	PublishedAt time.Time                `json:"publishedAt"`
+	Metrics     models.EngagementMetrics `json:"metrics"`
+	Clicks      int64                    `json:"clicks"`
+}
+
+type MetricsSummary struct {
+	TrackedPosts      int                      `json:"trackedPosts"`
+	Totals            models.EngagementMetrics `json:"totals"`
+	AverageEngagement float64                  `json:"averageEngagement"`
+	Clicks            int64                    `json:"clicks"`
+	TopPosts          []PostEngagement         `json:"topPosts"`
+	LastSyncedAt      *time.Time               `json:"lastSyncedAt,omitempty"`
+}
+
+// metricsSyncDelay returns how long to wait before syncing a post of the given age again.
+// Engagement settles quickly, so older posts are polled less often to stay within API limits.
+// The second return value is false once a post is too old to be worth syncing.
+func metricsSyncDelay(age time.Duration) (time.Duration, bool) {
+	const day = 24 * time.Hour
+	switch {
+	case age < day:
+		return time.Hour, true
+	case age < 7*day:
+		return 6 * time.Hour, true
+	case age < 30*day:
+		return day, true
+	case age < 90*day:
+		return 7 * day, true
+	default:
+		return 0, false
+	}
+}
+
+// SyncDueMetrics fetches engagement metrics for published posts whose next sync is due, on one instance at a time
+func (s *metricsService) SyncDueMetrics(ctx context.Context) error {
+	return withJobLease(ctx, s.leaseRepository, "metrics-sync", metricsSyncLease, s.syncDueMetrics)
+}
+
+func (s *metricsService) syncDueMetrics(ctx context.Context) error {
+	now := time.Now().UTC()
+	stories, err := s.storiesRepository.ListDueForMetricsSync(ctx, now, s.batchSize)
+	if err != nil {
+		return err
+	}
+	if len(stories) == 0 {
+		return nil
+	}
+
+	users := make(map[primitive.ObjectID]*models.User)
+	unauthorized := make(map[primitive.ObjectID]bool)
+	synced := 0
+
+	for i := range stories {
+		story := &stories[i]
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if story.Network != models.NetworkLinkedIn {
+			_ = s.storiesRepository.UpdateMetrics(ctx, story.ID, nil, now, nil)
+			continue
+		}
+
+		user, ok := users[story.UserID]
+		if !ok {
+			user, err = s.userRepository.FindByID(ctx, story.UserID.Hex())
+			if err != nil {
+				log.Logger.Warn("Failed to load user for metrics sync", zap.String("userId", story.UserID.Hex()), zap.Error(err))
+			}
+			users[story.UserID] = user
+		}
+
+		if user == nil || user.LinkedinAccessToken == "" || unauthorized[story.UserID] {
+			retryAt := now.Add(24 * time.Hour)
+			_ = s.storiesRepository.UpdateMetrics(ctx, story.ID, nil, now, &retryAt)
+			continue
+		}
+
+		metrics, err := s.fetchLinkedInMetrics(ctx, user.LinkedinAccessToken, story)
+		if err != nil {
+			if errors.Is(err, ErrLinkedInUnauthorized) {
+				unauthorized[story.UserID] = true
+			}
+			log.Logger.Warn("Failed to fetch post metrics",
+				zap.String("storyId", story.ID.Hex()),
+				zap.String("externalPostId", story.ExternalPostID),
+				zap.Error(err),
+			)
+			retryAt := now.Add(time.Hour)
+			_ = s.storiesRepository.UpdateMetrics(ctx, story.ID, nil, now, &retryAt)
+			continue
+		}
+
+		snapshot := &models.PostMetricsSnapshot{
+			StoryID:           story.ID,
+			UserID:            story.UserID,
+			Network:           story.Network,
+			ExternalPostID:    story.ExternalPostID,
+			EngagementMetrics: *metrics,
+			CollectedAt:       now,
+		}
+		if _, err := s.metricsRepository.Create(ctx, snapshot); err != nil {
+			continue
+		}
+
+		var nextSyncAt *time.Time
+		if delay, ok := metricsSyncDelay(now.Sub(story.CreatedAt)); ok {
+			next := now.Add(delay)
+			nextSyncAt = &next
+		}
+		_ = s.storiesRepository.UpdateMetrics(ctx, story.ID, metrics, now, nextSyncAt)
+		synced++
+	}
+
+	log.Logger.Info("Post metrics sync completed",
+		zap.Int("due", len(stories)),
+		zap.Int("synced", synced),
+	)
+	return nil
+}
+
+func (s *metricsService) fetchLinkedInMetrics(ctx context.Context, accessToken string, story *models.SocialPostStories) (*models.EngagementMetrics, error) {
+	// Share counts are only exposed for posts authored by organization pages
+	if author, _ := story.Payload["author"].(string); strings.HasPrefix(author, "urn:li:organization:") {
+		return s.linkedInClient.GetOrganizationShareStatistics(ctx, accessToken, author, story.ExternalPostID)
+	}
+	return s.linkedInClient.GetSocialActions(ctx, accessToken, story.ExternalPostID)
+}
+
+// GetStoryMetrics returns the engagement time series of a published post
+func (s *metricsService) GetStoryMetrics(ctx context.Context, userID, storyID primitive.ObjectID) (*StoryMetricsResponse, error) {
+	story, err := s.storiesRepository.GetByID(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if story == nil || story.UserID != userID {
+		return nil, ErrStoryNotFound
+	}
+
+	series, err := s.metricsRepository.ListByStory(ctx, storyID, 0)
+	if err != nil {
+		return nil, err
+	}
+	if series == nil {
+		series = []models.PostMetricsSnapshot{}
+	}
+
+	links, err := s.linkRepository.ListByStory(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &StoryMetricsResponse{
+		StoryID:         storyID.Hex(),
+		Network:         story.Network,
+		ExternalPostID:  story.ExternalPostID,
+		Latest:          story.LatestMetrics,
+		MetricsSyncedAt: story.MetricsSyncedAt,
+		Series:          series,
+		Links:           []models.ShortLink{},
+	}
+	for _, link := range links {
+		resp.Clicks += link.Clicks
+		resp.Links = append(resp.Links, link)
+	}
+	return resp, nil
+}
+
+// GetUserSummary aggregates the latest engagement metrics and short link clicks of every published post of a user
+func (s *metricsService) GetUserSummary(ctx context.Context, userID primitive.ObjectID) (*MetricsSummary, error) {
+	stories, err := s.storiesRepository.ListByUser(ctx, userID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	storyIDs := make([]primitive.ObjectID, len(stories))
+	for i, story := range stories {
+		storyIDs[i] = story.ID
+	}
+	clicks, err := s.linkRepository.CountClicksByStories(ctx, storyIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &MetricsSummary{TopPosts: []PostEngagement{}}
+	var posts []PostEngagement
+	for _, story := range stories {
+		summary.Clicks += clicks[story.ID]
+		if story.LatestMetrics == nil {
+			continue
+		}
+		summary.TrackedPosts++
+		summary.Totals.Reactions += story.LatestMetrics.Reactions
+		summary.Totals.Comments += story.LatestMetrics.Comments
+		if shares := story.LatestMetrics.Shares; shares != nil {
+			if summary.Totals.Shares == nil {
+				summary.Totals.Shares = new(int)
+			}
+			*summary.Totals.Shares += *shares
+		}
+		summary.Totals.Impressions += story.LatestMetrics.Impressions
+		if story.MetricsSyncedAt != nil && (summary.LastSyncedAt == nil || story.MetricsSyncedAt.After(*summary.LastSyncedAt)) {
+			summary.LastSyncedAt = story.MetricsSyncedAt
+		}
+		posts = append(posts, PostEngagement{
+			StoryID:     story.ID.Hex(),
+			Network:     story.Network,
+			PostContent: truncateString(story.PostContent, 200),
+			PublishedAt: story.CreatedAt,
+			Metrics:     *story.LatestMetrics,
+			Clicks:      clicks[story.ID],
+		})
+	}
+
+	if summary.TrackedPosts > 0 {
+		summary.AverageEngagement = float64(summary.Totals.Total()) / float64(summary.TrackedPosts)
+	}
+
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].Metrics.Total() > posts[j].Metrics.Total()
+	})
+	if len(posts) > metricsSummaryTopPosts {
+		posts = posts[:metricsSummaryTopPosts]
+	}
+	summary.TopPosts = append(summary.TopPosts, posts...)
+
+	return summary, nil
+}
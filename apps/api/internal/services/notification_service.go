@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var ErrNotificationNotFound = errors.New("notification not found")
+
+type NotificationService interface {
+	Notify(ctx context.Context, notification *models.Notification)
+	List(ctx context.Context, userID primitive.ObjectID, unreadOnly bool, limit int) ([]models.Notification, error)
+	MarkRead(ctx context.Context, userID, notificationID primitive.ObjectID) error
+}
+
+type notificationService struct {
+	notificationRepository repositories.NotificationRepository
+}
+
+func NewNotificationService(notificationRepo repositories.NotificationRepository) NotificationService {
+	return &notificationService{notificationRepository: notificationRepo}
+}
+
+// Notify stores an in-app notification. Failures are logged and never abort the action that triggered it.
+func (s *notificationService) Notify(ctx context.Context, notification *models.Notification) {
+	notification.Read = false
+	notification.CreatedAt = time.Now().UTC()
+	if _, err := s.notificationRepository.Create(ctx, notification); err != nil {
+		log.Logger.Warn("Failed to store notification",
+			zap.String("userId", notification.UserID.Hex()),
+			zap.String("type", string(notification.Type)),
+			zap.Error(err),
+		)
+	}
+}
+
+func (s *notificationService) List(ctx context.Context, userID primitive.ObjectID, unreadOnly bool, limit int) ([]models.Notification, error) {
+	notifications, err := s.notificationRepository.ListByUser(ctx, userID, unreadOnly, limit)
+	if err != nil {
+		return nil, err
+	}
+	if notifications == nil {
+		notifications = []models.Notification{}
+	}
+	return notifications, nil
+}
+
+func (s *notificationService) MarkRead(ctx context.Context, userID, notificationID primitive.ObjectID) error {
+	found, err := s.notificationRepository.MarkRead(ctx, userID, notificationID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrNotificationNotFound
+	}
+	return nil
+}
@@ -35,12 +35,23 @@ type OpenAIChatResponse struct {
 	Model string                 `json:"model"`
 }
 
+const (
+	defaultMaxTokens = 256
+	// partMaxTokens is the completion budget of each part of a multi-part post
+	partMaxTokens = 160
+)
+
 func (c *OpenAIClient) GenerateText(ctx context.Context, apiKey, model, prompt string) (string, string, map[string]interface{}, error) {
+	return c.GenerateTextWithLimit(ctx, apiKey, model, prompt, defaultMaxTokens)
+}
+
+// GenerateTextWithLimit is GenerateText with a custom completion budget, for longer outputs
+func (c *OpenAIClient) GenerateTextWithLimit(ctx context.Context, apiKey, model, prompt string, maxTokens int) (string, string, map[string]interface{}, error) {
 	url := "https://api.openai.com/v1/chat/completions"
 	requestBody := OpenAIChatRequest{
 		Model:       model,
 		Messages:    []OpenAIMessage{{Role: "user", Content: prompt}},
-		MaxTokens:   256,
+		MaxTokens:   maxTokens,
 		Temperature: 0.7,
 	}
 	bodyBytes, err := json.Marshal(requestBody)
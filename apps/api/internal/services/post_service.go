@@ -4,11 +4,16 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/postpilot/api/internal/config"
+	"github.com/postpilot/api/internal/httpclient"
 	"github.com/postpilot/api/internal/log"
 	"github.com/postpilot/api/internal/models"
 	"github.com/postpilot/api/internal/repositories"
@@ -17,28 +22,62 @@ import (
 	"go.uber.org/zap"
 )
 
+const defaultOpenAIModel = "gpt-3.5-turbo"
+
+var (
+	ErrPostNotFound         = errors.New("post not found")
+	ErrEmptyPostText        = errors.New("post has no text to publish")
+	ErrUnsupportedNetwork   = errors.New("unsupported network")
+	ErrLinkedInNotConnected = errors.New("LinkedIn not connected for this user")
+
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+)
+
 type PostService interface {
-	GeneratePost(ctx context.Context, user *models.User, topic string) (*GeneratePostResponse, error)
-	PublishOnLinkedIn(ctx context.Context, userID primitive.ObjectID, postLogID primitive.ObjectID, accessToken, personUrn, text string) (string, error)
+	GeneratePost(ctx context.Context, user *models.User, topic string, parts int) (*GeneratePostResponse, error)
+	CrossPost(ctx context.Context, user *models.User, postLogID primitive.ObjectID, targets []PublishTarget) (*CrossPostResponse, error)
+	PublishDraft(ctx context.Context, user *models.User, draft *models.Draft, targets []PublishTarget) (*CrossPostResponse, error)
+	EnsureApproved(ctx context.Context, user *models.User, postLogID primitive.ObjectID, text string) error
+	PublishOnLinkedIn(ctx context.Context, user *models.User, postLogID primitive.ObjectID, text string) (string, error)
 	DeleteLinkedInPost(ctx context.Context, userID primitive.ObjectID, postLogID primitive.ObjectID, accessToken, externalPostID string) error
 	ListPosts(ctx context.Context, userId primitive.ObjectID, limit int) ([]models.PostGenerationLog, error)
 }
 
 type postService struct {
 	openAIClient      *OpenAIClient
+	linkedInClient    *LinkedInClient
 	logRepository     repositories.PostGenerationLogRepository
 	storiesRepository repositories.SocialPostStoriesRepository
+	usePostsAPI       bool
+	carouselTheme     string
+	webhookService    WebhookService
+	linkService       LinkService
+	// linkedInConnections refreshes a LinkedIn token rejected on publish, or marks the connection as needs_reconnect
+	linkedInConnections LinkedInConnectionService
 }
 
-func NewPostServiceWithDeps(openAIClient *OpenAIClient, logRepo repositories.PostGenerationLogRepository, storiesRepo repositories.SocialPostStoriesRepository) PostService {
-	return &postService{openAIClient: openAIClient, logRepository: logRepo, storiesRepository: storiesRepo}
+func NewPostServiceWithDeps(openAIClient *OpenAIClient, linkedInClient *LinkedInClient, logRepo repositories.PostGenerationLogRepository, storiesRepo repositories.SocialPostStoriesRepository, webhookService WebhookService, linkService LinkService, linkedInConnections LinkedInConnectionService) PostService {
+	return &postService{
+		openAIClient:        openAIClient,
+		linkedInClient:      linkedInClient,
+		logRepository:       logRepo,
+		storiesRepository:   storiesRepo,
+		usePostsAPI:         config.Get().LinkedIn.UsePostsAPI,
+		carouselTheme:       config.Get().Carousel.Theme,
+		webhookService:      webhookService,
+		linkService:         linkService,
+		linkedInConnections: linkedInConnections,
+	}
 }
 
 func NewPostService() PostService {
 	// Fallback para inicialização padrão (para testes ou uso simples)
 	openAIClient := NewOpenAIClient()
+	linkedInClient := NewLinkedInClient(httpclient.New(httpclient.DefaultConfig()))
 	logRepo, _ := repositories.NewPostGenerationLogRepository()
-	return &postService{openAIClient: openAIClient, logRepository: logRepo}
+	storiesRepo, _ := repositories.NewSocialPostStoriesRepository()
+	// Built through NewPostServiceWithDeps so the LinkedIn API and carousel settings come from config in both cases
+	return NewPostServiceWithDeps(openAIClient, linkedInClient, logRepo, storiesRepo, nil, nil, nil)
 }
 
 type GeneratePostResponse struct {
@@ -47,15 +86,19 @@ type GeneratePostResponse struct {
 	Usage         map[string]interface{} `json:"usage,omitempty"`
 	CreatedAt     string                 `json:"createdAt"`
 	LogId         string                 `json:"logId"`
+	Parts         []models.PostPart      `json:"parts,omitempty"`
 }
 
-func (s *postService) GeneratePost(ctx context.Context, user *models.User, topic string) (*GeneratePostResponse, error) {
+// GeneratePost generates a post about topic. With parts > 0 the model is asked for that many
+// parts, returned both as a structured list and joined as the generated text.
+func (s *postService) GeneratePost(ctx context.Context, user *models.User, topic string, parts int) (*GeneratePostResponse, error) {
 	createdAt := time.Now().UTC()
 	userId := user.ID.Hex()
 
 	log.Logger.Info("Starting post generation",
 		zap.String("userId", userId),
 		zap.String("topic", topic),
+		zap.Int("parts", parts),
 		zap.Time("startedAt", createdAt),
 	)
 
@@ -63,7 +106,7 @@ func (s *postService) GeneratePost(ctx context.Context, user *models.User, topic
 		UserID:    user.ID,
 		Input:     topic,
 		CreatedAt: createdAt,
-		Status:    "started",
+		Status:    models.PostStatusStarted,
 	}
 	logId, _ := s.logRepository.Create(ctx, logEntry)
 
@@ -73,11 +116,19 @@ func (s *postService) GeneratePost(ctx context.Context, user *models.User, topic
 	)
 
 	prompt := "Gere uma sugestão de post para redes sociais a partir do seguinte tema/artigo: " + topic
-	apiKey := user.OpenAiApiKey
-	model := user.OpenAiModel
-	if model == "" {
-		model = "gpt-3.5-turbo"
+	maxTokens := defaultMaxTokens
+	if parts > 0 {
+		prompt = fmt.Sprintf(
+			"Gere um post para redes sociais dividido em exatamente %d partes, a partir do seguinte tema/artigo: %s\n\n"+
+				"Cada parte deve se sustentar sozinha como um slide de carrossel ou uma resposta em uma thread, com um título curto e um texto de até 300 caracteres. "+
+				"A primeira parte deve prender a atenção e a última deve trazer uma conclusão ou chamada para ação. "+
+				`Responda apenas com um array JSON de objetos no formato {"title": "...", "text": "..."}.`,
+			parts, topic,
+		)
+		maxTokens = partMaxTokens * parts
 	}
+	apiKey := user.OpenAiApiKey
+	model := userOpenAIModel(user)
 
 	log.Logger.Info("Calling OpenAI API",
 		zap.String("userId", userId),
@@ -86,21 +137,36 @@ func (s *postService) GeneratePost(ctx context.Context, user *models.User, topic
 	)
 
 	startTime := time.Now()
-	output, usedModel, usage, err := s.openAIClient.GenerateText(ctx, apiKey, model, prompt)
+	output, usedModel, usage, err := s.openAIClient.GenerateTextWithLimit(ctx, apiKey, model, prompt, maxTokens)
 	duration := time.Since(startTime)
 
-	update := bson.M{
-		"$set": bson.M{
-			"model":  usedModel,
-			"usage":  usage,
-			"output": output,
-			"status": "success",
-		},
+	var generatedParts []models.PostPart
+	if err == nil && parts > 0 {
+		generatedParts, err = parsePostParts(output, parts)
+		if err == nil {
+			output = models.JoinPostParts(generatedParts)
+		}
+	}
+
+	set := bson.M{
+		"model":  usedModel,
+		"usage":  usage,
+		"output": output,
+	}
+	if len(generatedParts) > 0 {
+		set["parts"] = generatedParts
+	}
+	transition := models.StatusTransition{
+		From:    string(models.PostStatusStarted),
+		To:      string(models.PostStatusSuccess),
+		ActorID: user.ID,
+		At:      time.Now().UTC(),
 	}
 
 	if err != nil {
-		update["$set"].(bson.M)["status"] = "error"
-		update["$set"].(bson.M)["error"] = err.Error()
+		set["error"] = err.Error()
+		transition.To = string(models.PostStatusError)
+		transition.Reason = err.Error()
 
 		log.Logger.Error("Post generation failed",
 			zap.String("userId", userId),
@@ -143,7 +209,22 @@ func (s *postService) GeneratePost(ctx context.Context, user *models.User, topic
 		)
 	}
 
-	_ = s.logRepository.UpdateByID(ctx, logId, update)
+	_, _ = s.logRepository.TransitionStatus(ctx, logId, transition, set)
+
+	if err != nil {
+		s.emit(ctx, user.ID, models.WebhookPostFailed, map[string]interface{}{
+			"postLogId": logId.Hex(),
+			"stage":     "generation",
+			"error":     err.Error(),
+		})
+	} else {
+		s.emit(ctx, user.ID, models.WebhookPostGenerated, map[string]interface{}{
+			"postLogId": logId.Hex(),
+			"model":     usedModel,
+			"text":      output,
+			"parts":     generatedParts,
+		})
+	}
 
 	return &GeneratePostResponse{
 		GeneratedText: output,
@@ -151,9 +232,628 @@ func (s *postService) GeneratePost(ctx context.Context, user *models.User, topic
 		Usage:         usage,
 		CreatedAt:     createdAt.Format(time.RFC3339),
 		LogId:         logId.Hex(),
+		Parts:         generatedParts,
 	}, err
 }
 
+// PublishTarget is a network that a post should be published to, with an optional text override.
+// Campaign is the utm_campaign of the links of the post; the configured default is used when empty.
+type PublishTarget struct {
+	Network  string
+	Text     string
+	Campaign string
+}
+
+// PublishTargetResult is the outcome of publishing a post to a single target
+type PublishTargetResult struct {
+	Network        string `json:"network"`
+	Status         string `json:"status"` // success, error
+	StoryID        string `json:"storyId,omitempty"`
+	ExternalPostID string `json:"externalPostId,omitempty"`
+	Error          string `json:"error,omitempty"`
+}
+
+// CrossPostResponse aggregates the per-target results of a cross-post request
+type CrossPostResponse struct {
+	PostLogID string                `json:"postLogId,omitempty"`
+	DraftID   string                `json:"draftId,omitempty"`
+	Status    string                `json:"status"` // published, partially_published, failed
+	Results   []PublishTargetResult `json:"results"`
+}
+
+// publishContent is what a cross-post publishes: a text and, for multi-part posts, its ordered parts
+type publishContent struct {
+	Text  string
+	Parts []models.PostPart
+	Theme string
+}
+
+// publishOrigin identifies what a published story was created from
+type publishOrigin struct {
+	PostLogID       primitive.ObjectID
+	DraftID         primitive.ObjectID
+	OriginalStoryID primitive.ObjectID
+}
+
+// CrossPost publishes a generated post to several networks at once. Each target is
+// published independently, so a failure on one network does not abort the others.
+func (s *postService) CrossPost(ctx context.Context, user *models.User, postLogID primitive.ObjectID, targets []PublishTarget) (*CrossPostResponse, error) {
+	postLog, err := s.logRepository.GetByID(ctx, postLogID)
+	if err != nil {
+		return nil, err
+	}
+	if postLog == nil || postLog.UserID != user.ID {
+		return nil, ErrPostNotFound
+	}
+	if err := ensurePostApproved(user, postLog); err != nil {
+		return nil, err
+	}
+	if err := ensureApprovedTargets(user, postLog, targets); err != nil {
+		return nil, err
+	}
+	if !postLog.Status.CanTransitionTo(models.PostStatusPublished) {
+		return nil, fmt.Errorf("%w: cannot publish a post that is %s", ErrInvalidStatusTransition, postLog.Status)
+	}
+
+	content := publishContent{Text: postLog.Output}
+	if len(postLog.Parts) > 0 {
+		// Generated parts have no separate caption, so the first part doubles as the post text
+		content = publishContent{Text: postLog.Parts[0].Text, Parts: postLog.Parts}
+	}
+	return s.crossPost(ctx, user, publishOrigin{PostLogID: postLogID}, content, targets)
+}
+
+// PublishDraft publishes the text of a draft to several networks, like CrossPost does for generated posts
+func (s *postService) PublishDraft(ctx context.Context, user *models.User, draft *models.Draft, targets []PublishTarget) (*CrossPostResponse, error) {
+	if draft.PostGenerationLogID != primitive.NilObjectID {
+		postLog, err := s.logRepository.GetByID(ctx, draft.PostGenerationLogID)
+		if err != nil {
+			return nil, err
+		}
+		if postLog == nil || postLog.UserID != user.ID {
+			return nil, ErrPostNotFound
+		}
+		if err := ensurePostApproved(user, postLog); err != nil {
+			return nil, err
+		}
+		if err := ensureApprovedDraft(user, postLog, draft); err != nil {
+			return nil, err
+		}
+		if err := ensureApprovedTargets(user, postLog, targets); err != nil {
+			return nil, err
+		}
+		if !postLog.Status.CanTransitionTo(models.PostStatusPublished) {
+			return nil, fmt.Errorf("%w: cannot publish a post that is %s", ErrInvalidStatusTransition, postLog.Status)
+		}
+	} else if user.RequiresApproval() {
+		// Only generated posts go through review, so a standalone draft can never be approved.
+		// Evergreen reshares are exempt while they keep the text that was already published.
+		if err := s.ensureUneditedReshare(ctx, user, draft); err != nil {
+			return nil, err
+		}
+	}
+
+	origin := publishOrigin{PostLogID: draft.PostGenerationLogID, DraftID: draft.ID, OriginalStoryID: draft.EvergreenStoryID}
+	content := publishContent{Text: draft.Text, Parts: draft.Parts, Theme: draft.Theme}
+	if content.Text == "" && len(draft.Parts) > 0 {
+		content.Text = draft.Parts[0].Text
+	}
+	return s.crossPost(ctx, user, origin, content, targets)
+}
+
+// crossPost publishes content to every target in parallel. Targets with a text override get a plain text post.
+func (s *postService) crossPost(ctx context.Context, user *models.User, origin publishOrigin, content publishContent, targets []PublishTarget) (*CrossPostResponse, error) {
+	log.Logger.Info("Starting cross-post",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("postLogId", origin.PostLogID.Hex()),
+		zap.String("draftId", origin.DraftID.Hex()),
+		zap.Int("targets", len(targets)),
+	)
+
+	results := make([]PublishTargetResult, len(targets))
+	var wg sync.WaitGroup
+	for i, target := range targets {
+		wg.Add(1)
+		go func(idx int, target PublishTarget) {
+			defer wg.Done()
+			results[idx] = s.publishTarget(ctx, user, origin, content, target)
+		}(i, target)
+	}
+	wg.Wait()
+
+	succeeded := 0
+	for _, res := range results {
+		if res.Status == "success" {
+			succeeded++
+		}
+	}
+	s.updatePublicationStatus(ctx, user.ID, origin.PostLogID, succeeded, len(targets))
+
+	status := "failed"
+	switch {
+	case succeeded == len(targets):
+		status = "published"
+	case succeeded > 0:
+		status = "partially_published"
+	}
+
+	log.Logger.Info("Cross-post completed",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("postLogId", origin.PostLogID.Hex()),
+		zap.String("draftId", origin.DraftID.Hex()),
+		zap.String("status", status),
+		zap.Int("succeeded", succeeded),
+		zap.Int("failed", len(targets)-succeeded),
+	)
+
+	resp := &CrossPostResponse{Status: status, Results: results}
+	if origin.PostLogID != primitive.NilObjectID {
+		resp.PostLogID = origin.PostLogID.Hex()
+	}
+	if origin.DraftID != primitive.NilObjectID {
+		resp.DraftID = origin.DraftID.Hex()
+	}
+
+	event := models.WebhookPostPublished
+	if succeeded == 0 {
+		event = models.WebhookPostFailed
+	}
+	s.emit(ctx, user.ID, event, map[string]interface{}{
+		"postLogId": resp.PostLogID,
+		"draftId":   resp.DraftID,
+		"stage":     "publish",
+		"status":    status,
+		"results":   results,
+	})
+	return resp, nil
+}
+
+// emit sends a webhook event for the user, if webhooks are wired in
+func (s *postService) emit(ctx context.Context, userID primitive.ObjectID, event models.WebhookEvent, data map[string]interface{}) {
+	if s.webhookService == nil {
+		return
+	}
+	s.webhookService.Dispatch(ctx, userID, event, data)
+}
+
+// emitStoryResult sends the post.published or post.failed webhook event for a story published outside
+// of a cross-post, if webhooks are wired in
+func emitStoryResult(ctx context.Context, webhookService WebhookService, userID primitive.ObjectID, story *models.SocialPostStories) {
+	if webhookService == nil {
+		return
+	}
+	event, status := models.WebhookPostPublished, "published"
+	if story.Status != models.StoryStatusSuccess {
+		event, status = models.WebhookPostFailed, "failed"
+	}
+	result := PublishTargetResult{
+		Network:        story.Network,
+		Status:         string(story.Status),
+		ExternalPostID: story.ExternalPostID,
+		Error:          story.Error,
+	}
+	if !story.ID.IsZero() {
+		result.StoryID = story.ID.Hex()
+	}
+	webhookService.Dispatch(ctx, userID, event, map[string]interface{}{
+		"stage":   "publish",
+		"status":  status,
+		"results": []PublishTargetResult{result},
+	})
+}
+
+func (s *postService) publishTarget(ctx context.Context, user *models.User, origin publishOrigin, content publishContent, target PublishTarget) PublishTargetResult {
+	result := PublishTargetResult{Network: target.Network, Status: "error"}
+
+	var story *models.SocialPostStories
+	var linkIDs []primitive.ObjectID
+	var err error
+	switch {
+	case target.Text != "":
+		var texts []string
+		texts, linkIDs = s.trackLinks(ctx, user.ID, target, []string{target.Text})
+		story, err = s.publishToNetwork(ctx, user, origin, target.Network, texts[0])
+	case len(content.Parts) > 0:
+		texts := []string{content.Text}
+		for _, part := range content.Parts {
+			texts = append(texts, part.Text)
+		}
+		texts, linkIDs = s.trackLinks(ctx, user.ID, target, texts)
+		tracked := publishContent{Text: texts[0], Parts: make([]models.PostPart, len(content.Parts)), Theme: content.Theme}
+		for i, part := range content.Parts {
+			tracked.Parts[i] = models.PostPart{Title: part.Title, Text: texts[i+1]}
+		}
+		story, err = s.publishPartsToNetwork(ctx, user, origin, target.Network, tracked)
+	case content.Text != "":
+		var texts []string
+		texts, linkIDs = s.trackLinks(ctx, user.ID, target, []string{content.Text})
+		story, err = s.publishToNetwork(ctx, user, origin, target.Network, texts[0])
+	default:
+		result.Error = ErrEmptyPostText.Error()
+		return result
+	}
+
+	if story != nil && story.ID != primitive.NilObjectID {
+		result.StoryID = story.ID.Hex()
+	}
+	if err != nil {
+		log.Logger.Warn("Cross-post target failed",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("postLogId", origin.PostLogID.Hex()),
+			zap.String("draftId", origin.DraftID.Hex()),
+			zap.String("network", target.Network),
+			zap.Error(err),
+		)
+		result.Error = err.Error()
+		return result
+	}
+
+	result.Status = "success"
+	result.ExternalPostID = story.ExternalPostID
+	if s.linkService != nil {
+		s.linkService.AttachStory(ctx, linkIDs, story.ID)
+	}
+	return result
+}
+
+// trackLinks tags the links of the texts a target publishes for link tracking. It returns the
+// rewritten texts along with the short links created for them.
+func (s *postService) trackLinks(ctx context.Context, userID primitive.ObjectID, target PublishTarget, texts []string) ([]string, []primitive.ObjectID) {
+	if s.linkService == nil {
+		return texts, nil
+	}
+	return s.linkService.Track(ctx, userID, target.Network, target.Campaign, texts)
+}
+
+func (s *postService) publishToNetwork(ctx context.Context, user *models.User, origin publishOrigin, network, text string) (*models.SocialPostStories, error) {
+	switch network {
+	case models.NetworkLinkedIn:
+		if user.LinkedinAccessToken == "" || user.LinkedinPersonUrn == "" {
+			return nil, ErrLinkedInNotConnected
+		}
+		return s.withLinkedInToken(ctx, user, func(accessToken string) (*models.SocialPostStories, error) {
+			return s.publishLinkedInStory(ctx, user.ID, origin, accessToken, user.LinkedinPersonUrn, text)
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedNetwork, network)
+	}
+}
+
+// publishPartsToNetwork publishes a multi-part post, as a document carousel on LinkedIn
+func (s *postService) publishPartsToNetwork(ctx context.Context, user *models.User, origin publishOrigin, network string, content publishContent) (*models.SocialPostStories, error) {
+	switch network {
+	case models.NetworkLinkedIn:
+		if user.LinkedinAccessToken == "" || user.LinkedinPersonUrn == "" {
+			return nil, ErrLinkedInNotConnected
+		}
+		if err := validateCarouselParts(content.Parts); err != nil {
+			return nil, err
+		}
+		return s.withLinkedInToken(ctx, user, func(accessToken string) (*models.SocialPostStories, error) {
+			return s.publishLinkedInDocument(ctx, user, origin, accessToken, content)
+		})
+	default:
+		return nil, fmt.Errorf("%w: %s does not support multi-part posts", ErrUnsupportedNetwork, network)
+	}
+}
+
+// publishLinkedInDocument renders the parts into a PDF, one slide per part, and publishes it as a
+// LinkedIn document post. Documents only exist in the versioned API, whatever LINKEDIN_USE_POSTS_API says.
+func (s *postService) publishLinkedInDocument(ctx context.Context, user *models.User, origin publishOrigin, accessToken string, content publishContent) (*models.SocialPostStories, error) {
+	createdAt := time.Now().UTC()
+	logEntry := &models.SocialPostStories{
+		UserID:              user.ID,
+		PostGenerationLogID: origin.PostLogID,
+		DraftID:             origin.DraftID,
+		OriginalStoryID:     origin.OriginalStoryID,
+		Network:             models.NetworkLinkedIn,
+		PostContent:         content.Text,
+		Format:              models.PostFormatDocument,
+		Parts:               content.Parts,
+		CreatedAt:           createdAt,
+		UpdatedAt:           createdAt,
+		Status:              models.StoryStatusStarted,
+	}
+
+	pdf := renderCarouselPDF(content.Parts, resolveCarouselTheme(content.Theme, s.carouselTheme))
+	documentURN, err := s.linkedInClient.UploadDocument(ctx, accessToken, user.LinkedinPersonUrn, pdf)
+	if err != nil {
+		logEntry.Status = models.StoryStatusError
+		logEntry.Error = err.Error()
+		logEntry.UpdatedAt = time.Now().UTC()
+		_, _ = s.storiesRepository.Create(ctx, logEntry)
+		return nil, err
+	}
+	logEntry.DocumentURN = documentURN
+	logEntry.Payload = newDocumentPostPayload(user.LinkedinPersonUrn, content.Text, documentURN, carouselTitle(content.Parts))
+
+	postURN, err := s.linkedInClient.CreatePost(ctx, accessToken, logEntry.Payload)
+	logEntry.UpdatedAt = time.Now().UTC()
+	if err != nil {
+		logEntry.Status = models.StoryStatusError
+		logEntry.Error = err.Error()
+		_, _ = s.storiesRepository.Create(ctx, logEntry)
+		return nil, err
+	}
+
+	log.Logger.Info("LinkedIn document post published successfully",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("linkedinPostId", postURN),
+		zap.String("documentUrn", documentURN),
+		zap.Int("slides", len(content.Parts)),
+	)
+	logEntry.Status = models.StoryStatusSuccess
+	logEntry.ExternalPostID = postURN
+	logEntry.ID, _ = s.storiesRepository.Create(ctx, logEntry)
+	return logEntry, nil
+}
+
+// withLinkedInToken publishes with the user's LinkedIn token and, when LinkedIn rejects it, retries once with
+// a refreshed one. A token that cannot be refreshed leaves the connection marked as needs_reconnect.
+func (s *postService) withLinkedInToken(ctx context.Context, user *models.User, publish func(accessToken string) (*models.SocialPostStories, error)) (*models.SocialPostStories, error) {
+	story, err := publish(user.LinkedinAccessToken)
+	if !errors.Is(err, ErrLinkedInUnauthorized) || s.linkedInConnections == nil {
+		return story, err
+	}
+
+	accessToken, reauthErr := s.linkedInConnections.Reauthorize(ctx, user)
+	if reauthErr != nil {
+		if !errors.Is(reauthErr, ErrLinkedInUnauthorized) {
+			log.Logger.Warn("Failed to refresh LinkedIn token after publish was rejected",
+				zap.String("userId", user.ID.Hex()),
+				zap.Error(reauthErr),
+			)
+		}
+		return story, err
+	}
+	log.Logger.Info("Retrying LinkedIn publish with a refreshed token", zap.String("userId", user.ID.Hex()))
+	return publish(accessToken)
+}
+
+// EnsureApproved refuses to publish a post that has not been approved, or text other than the approved one,
+// when the user has the approval workflow enabled
+func (s *postService) EnsureApproved(ctx context.Context, user *models.User, postLogID primitive.ObjectID, text string) error {
+	return ensureApprovedPost(ctx, s.logRepository, user, postLogID, text)
+}
+
+// ensureApprovedPost is EnsureApproved for the publishers outside postService: without a postLogID nothing
+// can have been approved, and text, when given, must be the approved one
+func ensureApprovedPost(ctx context.Context, logRepository repositories.PostGenerationLogRepository, user *models.User, postLogID primitive.ObjectID, text string) error {
+	if !user.RequiresApproval() {
+		return nil
+	}
+	if postLogID == primitive.NilObjectID {
+		return ErrPostNotApproved
+	}
+
+	postLog, err := logRepository.GetByID(ctx, postLogID)
+	if err != nil {
+		return err
+	}
+	if postLog == nil || postLog.UserID != user.ID {
+		return ErrPostNotFound
+	}
+	if err := ensurePostApproved(user, postLog); err != nil {
+		return err
+	}
+	return ensureApprovedText(user, postLog, text)
+}
+
+func ensurePostApproved(user *models.User, postLog *models.PostGenerationLog) error {
+	if !user.RequiresApproval() {
+		return nil
+	}
+	switch postLog.CurrentReviewStatus() {
+	case models.ReviewStatusApproved, models.ReviewStatusScheduled, models.ReviewStatusPublished:
+		// Published posts stay approved, so the targets that failed on a partial publish can be retried
+		return nil
+	}
+	log.Logger.Warn("Publish refused for unapproved post",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("postLogId", postLog.ID.Hex()),
+		zap.String("reviewStatus", string(postLog.CurrentReviewStatus())),
+	)
+	return ErrPostNotApproved
+}
+
+// ensureApprovedTargets refuses targets whose text override differs from the approved post
+func ensureApprovedTargets(user *models.User, postLog *models.PostGenerationLog, targets []PublishTarget) error {
+	for _, target := range targets {
+		if err := ensureApprovedText(user, postLog, target.Text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureApprovedDraft refuses a draft whose text or parts were edited after its post was approved
+func ensureApprovedDraft(user *models.User, postLog *models.PostGenerationLog, draft *models.Draft) error {
+	if !user.RequiresApproval() {
+		return nil
+	}
+	if err := ensureApprovedText(user, postLog, draft.Text); err != nil {
+		return err
+	}
+	if len(draft.Parts) == 0 || samePostParts(draft.Parts, postLog.Parts) {
+		return nil
+	}
+	log.Logger.Warn("Publish refused for parts that differ from the approved post",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("postLogId", postLog.ID.Hex()),
+		zap.String("draftId", draft.ID.Hex()),
+	)
+	return fmt.Errorf("%w: the parts differ from the approved version, submit them for review", ErrPostNotApproved)
+}
+
+// ensureUneditedReshare refuses a draft that is not an evergreen reshare of its original text and parts
+func (s *postService) ensureUneditedReshare(ctx context.Context, user *models.User, draft *models.Draft) error {
+	if draft.EvergreenStoryID.IsZero() {
+		return ErrPostNotApproved
+	}
+	original, err := s.storiesRepository.GetByID(ctx, draft.EvergreenStoryID)
+	if err != nil {
+		return err
+	}
+	if original == nil || original.UserID != user.ID {
+		return ErrPostNotApproved
+	}
+	if strings.TrimSpace(draft.Text) != strings.TrimSpace(original.PostContent) || !samePostParts(draft.Parts, original.Parts) {
+		log.Logger.Warn("Publish refused for evergreen reshare edited after it was queued",
+			zap.String("userId", user.ID.Hex()),
+			zap.String("draftId", draft.ID.Hex()),
+			zap.String("storyId", original.ID.Hex()),
+		)
+		return fmt.Errorf("%w: the reshare was edited after it was queued", ErrPostNotApproved)
+	}
+	return nil
+}
+
+// samePostParts reports whether two lists of parts have the same titles and texts, ignoring surrounding spaces
+func samePostParts(a, b []models.PostPart) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if strings.TrimSpace(a[i].Title) != strings.TrimSpace(b[i].Title) || strings.TrimSpace(a[i].Text) != strings.TrimSpace(b[i].Text) {
+			return false
+		}
+	}
+	return true
+}
+
+// ensureApprovedText refuses to publish text other than the approved one, since only that text was reviewed.
+// The first part of a multi-part post is accepted too, as it is what gets published as its text.
+func ensureApprovedText(user *models.User, postLog *models.PostGenerationLog, text string) error {
+	if !user.RequiresApproval() || text == "" {
+		return nil
+	}
+	text = strings.TrimSpace(text)
+	if text == strings.TrimSpace(postLog.Output) || (len(postLog.Parts) > 0 && text == strings.TrimSpace(postLog.Parts[0].Text)) {
+		return nil
+	}
+	log.Logger.Warn("Publish refused for text that differs from the approved post",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("postLogId", postLog.ID.Hex()),
+	)
+	return fmt.Errorf("%w: the text differs from the approved version, submit it for review", ErrPostNotApproved)
+}
+
+// updatePublicationStatus reflects how many targets of a post were published on its generation log
+func (s *postService) updatePublicationStatus(ctx context.Context, actorID, postLogID primitive.ObjectID, succeeded, total int) {
+	if postLogID == primitive.NilObjectID || succeeded == 0 {
+		return
+	}
+
+	status := models.PostStatusPublished
+	if succeeded < total {
+		status = models.PostStatusPartiallyPublished
+	}
+	now := time.Now().UTC()
+	reason := fmt.Sprintf("%d of %d targets published", succeeded, total)
+	if err := s.transitionPost(ctx, postLogID, actorID, status, reason, bson.M{"publishedAt": now}); err != nil {
+		log.Logger.Warn("Post publication status not updated", zap.String("postLogId", postLogID.Hex()), zap.Error(err))
+	}
+
+	markReviewPublished(ctx, s.logRepository, postLogID, actorID)
+}
+
+// markReviewPublished completes the review workflow of an approved or scheduled post once it is published; a no-op
+// for posts outside the workflow or already published
+func markReviewPublished(ctx context.Context, logRepository repositories.PostGenerationLogRepository, postLogID, actorID primitive.ObjectID) {
+	if postLogID == primitive.NilObjectID {
+		return
+	}
+	for _, from := range []models.ReviewStatus{models.ReviewStatusApproved, models.ReviewStatusScheduled} {
+		updated, err := logRepository.TransitionReview(ctx, postLogID, from, models.ReviewEvent{
+			From:    from,
+			To:      models.ReviewStatusPublished,
+			ActorID: actorID,
+			At:      time.Now().UTC(),
+		})
+		if err != nil {
+			log.Logger.Warn("Post review not marked as published", zap.String("postLogId", postLogID.Hex()), zap.Error(err))
+			return
+		}
+		if updated {
+			return
+		}
+	}
+}
+
+// transitionPost moves a post to the given status if the lifecycle allows it from its current status
+func (s *postService) transitionPost(ctx context.Context, postLogID, actorID primitive.ObjectID, to models.PostStatus, reason string, set bson.M) error {
+	postLog, err := s.logRepository.GetByID(ctx, postLogID)
+	if err != nil {
+		return err
+	}
+	if postLog == nil {
+		return ErrPostNotFound
+	}
+	// A post published again stays published even if only some of the new targets succeeded
+	if postLog.Status == models.PostStatusPublished && to == models.PostStatusPartiallyPublished {
+		to = models.PostStatusPublished
+	}
+	if !postLog.Status.CanTransitionTo(to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, postLog.Status, to)
+	}
+
+	ok, err := s.logRepository.TransitionStatus(ctx, postLogID, models.StatusTransition{
+		From:    string(postLog.Status),
+		To:      string(to),
+		ActorID: actorID,
+		Reason:  reason,
+		At:      time.Now().UTC(),
+	}, set)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: post status changed concurrently", ErrInvalidStatusTransition)
+	}
+	return nil
+}
+
+// userOpenAIModel returns the model configured by the user, falling back to the default model
+func userOpenAIModel(user *models.User) string {
+	if user.OpenAiModel == "" {
+		return defaultOpenAIModel
+	}
+	return user.OpenAiModel
+}
+
+// parsePostParts reads the model output as a JSON array of parts, falling back to one part per paragraph.
+// Extra parts are dropped, and fewer parts than count is an error.
+func parsePostParts(output string, count int) ([]models.PostPart, error) {
+	output = strings.TrimSpace(output)
+	output = strings.TrimPrefix(output, "```json")
+	output = strings.TrimSuffix(strings.TrimPrefix(output, "```"), "```")
+
+	var parts []models.PostPart
+	if err := json.Unmarshal([]byte(strings.TrimSpace(output)), &parts); err == nil {
+		filtered := parts[:0]
+		for _, p := range parts {
+			if strings.TrimSpace(p.Text) != "" {
+				filtered = append(filtered, p)
+			}
+		}
+		parts = filtered
+	} else {
+		for _, block := range strings.Split(output, "\n\n") {
+			if block = strings.TrimSpace(block); block != "" {
+				parts = append(parts, models.PostPart{Text: block})
+			}
+		}
+	}
+
+	if len(parts) == 0 {
+		return nil, errors.New("could not read the generated parts")
+	}
+	if len(parts) < count {
+		return nil, fmt.Errorf("the model generated %d parts instead of %d", len(parts), count)
+	}
+	return parts[:count], nil
+}
+
 func truncateString(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
@@ -161,14 +861,48 @@ func truncateString(s string, maxLen int) string {
 	return s[:maxLen] + "..."
 }
 
-func (s *postService) PublishOnLinkedIn(ctx context.Context, userID primitive.ObjectID, postLogID primitive.ObjectID, accessToken, personUrn, text string) (string, error) {
+func (s *postService) PublishOnLinkedIn(ctx context.Context, user *models.User, postLogID primitive.ObjectID, text string) (string, error) {
+	userID := user.ID
+	var linkIDs []primitive.ObjectID
+	if s.linkService != nil {
+		var texts []string
+		texts, linkIDs = s.linkService.Track(ctx, userID, models.NetworkLinkedIn, "", []string{text})
+		text = texts[0]
+	}
+
+	story, err := s.publishToNetwork(ctx, user, publishOrigin{PostLogID: postLogID}, models.NetworkLinkedIn, text)
+	data := map[string]interface{}{"stage": "publish", "network": models.NetworkLinkedIn}
+	if postLogID != primitive.NilObjectID {
+		data["postLogId"] = postLogID.Hex()
+	}
+	if err != nil {
+		data["error"] = err.Error()
+		s.emit(ctx, userID, models.WebhookPostFailed, data)
+		return "", err
+	}
+	s.updatePublicationStatus(ctx, userID, postLogID, 1, 1)
+	if s.linkService != nil {
+		s.linkService.AttachStory(ctx, linkIDs, story.ID)
+	}
+	data["externalPostId"] = story.ExternalPostID
+	s.emit(ctx, userID, models.WebhookPostPublished, data)
+	return story.ExternalPostID, nil
+}
+
+// publishLinkedInStory publishes text on LinkedIn and records the attempt as a SocialPostStories entry
+func (s *postService) publishLinkedInStory(ctx context.Context, userID primitive.ObjectID, origin publishOrigin, accessToken, personUrn, text string) (*models.SocialPostStories, error) {
 	log.Logger.Info("Starting LinkedIn publish",
 		zap.String("userId", userID.Hex()),
-		zap.String("postLogId", postLogID.Hex()),
+		zap.String("postLogId", origin.PostLogID.Hex()),
 		zap.String("personUrn", personUrn),
 		zap.Int("textLength", len(text)),
+		zap.Bool("postsApi", s.usePostsAPI),
 	)
 
+	if s.usePostsAPI {
+		return s.publishViaPostsAPI(ctx, userID, origin, accessToken, personUrn, text)
+	}
+
 	payload := map[string]interface{}{
 		"author":         personUrn,
 		"lifecycleState": "PUBLISHED",
@@ -187,13 +921,15 @@ func (s *postService) PublishOnLinkedIn(ctx context.Context, userID primitive.Ob
 	createdAt := time.Now().UTC()
 	logEntry := &models.SocialPostStories{
 		UserID:              userID,
-		PostGenerationLogID: postLogID,
-		Network:             "linkedin",
+		PostGenerationLogID: origin.PostLogID,
+		DraftID:             origin.DraftID,
+		OriginalStoryID:     origin.OriginalStoryID,
+		Network:             models.NetworkLinkedIn,
 		PostContent:         text,
 		Payload:             payload,
 		CreatedAt:           createdAt,
 		UpdatedAt:           createdAt,
-		Status:              "started",
+		Status:              models.StoryStatusStarted,
 	}
 
 	body, err := json.Marshal(payload)
@@ -201,11 +937,11 @@ func (s *postService) PublishOnLinkedIn(ctx context.Context, userID primitive.Ob
 		log.Logger.Error("Failed to marshal LinkedIn payload",
 			zap.Error(err),
 		)
-		logEntry.Status = "error"
+		logEntry.Status = models.StoryStatusError
 		logEntry.Error = err.Error()
 		logEntry.UpdatedAt = time.Now().UTC()
 		_, _ = s.storiesRepository.Create(ctx, logEntry)
-		return "", err
+		return nil, err
 	}
 
 	req, err := http.NewRequest("POST", "https://api.linkedin.com/v2/ugcPosts", bytes.NewBuffer(body))
@@ -213,11 +949,11 @@ func (s *postService) PublishOnLinkedIn(ctx context.Context, userID primitive.Ob
 		log.Logger.Error("Failed to create LinkedIn request",
 			zap.Error(err),
 		)
-		logEntry.Status = "error"
+		logEntry.Status = models.StoryStatusError
 		logEntry.Error = err.Error()
 		logEntry.UpdatedAt = time.Now().UTC()
 		_, _ = s.storiesRepository.Create(ctx, logEntry)
-		return "", err
+		return nil, err
 	}
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 	req.Header.Set("Content-Type", "application/json")
@@ -237,11 +973,11 @@ func (s *postService) PublishOnLinkedIn(ctx context.Context, userID primitive.Ob
 			zap.Error(err),
 			zap.Duration("duration", duration),
 		)
-		logEntry.Status = "error"
+		logEntry.Status = models.StoryStatusError
 		logEntry.Error = err.Error()
 		logEntry.UpdatedAt = time.Now().UTC()
 		_, _ = s.storiesRepository.Create(ctx, logEntry)
-		return "", err
+		return nil, err
 	}
 	defer resp.Body.Close()
 	respBody, _ := io.ReadAll(resp.Body)
@@ -260,20 +996,20 @@ func (s *postService) PublishOnLinkedIn(ctx context.Context, userID primitive.Ob
 			zap.Int("statusCode", resp.StatusCode),
 			zap.String("response", string(respBody)),
 		)
-		logEntry.Status = "error"
-		logEntry.Error = "LinkedIn token expired or invalid. Please reconnect your LinkedIn account."
+		logEntry.Status = models.StoryStatusError
+		logEntry.Error = ErrLinkedInUnauthorized.Error()
 		_, _ = s.storiesRepository.Create(ctx, logEntry)
-		return "", fmt.Errorf(logEntry.Error)
+		return nil, ErrLinkedInUnauthorized
 	}
 	if resp.StatusCode != 201 && resp.StatusCode != 200 {
 		log.Logger.Error("LinkedIn API error",
 			zap.Int("statusCode", resp.StatusCode),
 			zap.String("response", string(respBody)),
 		)
-		logEntry.Status = "error"
+		logEntry.Status = models.StoryStatusError
 		logEntry.Error = fmt.Sprintf("linkedin api error: %s", string(respBody))
 		_, _ = s.storiesRepository.Create(ctx, logEntry)
-		return "", fmt.Errorf(logEntry.Error)
+		return nil, errors.New(logEntry.Error)
 	}
 	var result struct {
 		ID string `json:"id"`
@@ -284,48 +1020,66 @@ func (s *postService) PublishOnLinkedIn(ctx context.Context, userID primitive.Ob
 			zap.String("linkedinPostId", result.ID),
 			zap.Duration("totalDuration", duration),
 		)
-		logEntry.Status = "success"
+		logEntry.Status = models.StoryStatusSuccess
 		logEntry.ExternalPostID = result.ID
-		_, _ = s.storiesRepository.Create(ctx, logEntry)
-
-		// Update PostGenerationLog status to "published"
-		if postLogID != primitive.NilObjectID {
-			_ = s.logRepository.UpdateByID(ctx, postLogID, bson.M{
-				"$set": bson.M{
-					"status":      "published",
-					"publishedAt": time.Now().UTC(),
-				},
-			})
-		}
-		return result.ID, nil
+		logEntry.ID, _ = s.storiesRepository.Create(ctx, logEntry)
+		return logEntry, nil
 	}
 	if postId := resp.Header.Get("x-restli-id"); postId != "" {
 		log.Logger.Info("LinkedIn post published successfully (from header)",
 			zap.String("linkedinPostId", postId),
 			zap.Duration("totalDuration", duration),
 		)
-		logEntry.Status = "success"
+		logEntry.Status = models.StoryStatusSuccess
 		logEntry.ExternalPostID = postId
-		_, _ = s.storiesRepository.Create(ctx, logEntry)
-
-		// Update PostGenerationLog status to "published"
-		if postLogID != primitive.NilObjectID {
-			_ = s.logRepository.UpdateByID(ctx, postLogID, bson.M{
-				"$set": bson.M{
-					"status":      "published",
-					"publishedAt": time.Now().UTC(),
-				},
-			})
-		}
-		return postId, nil
+		logEntry.ID, _ = s.storiesRepository.Create(ctx, logEntry)
+		return logEntry, nil
 	}
 	log.Logger.Error("LinkedIn publish failed - no post ID returned",
 		zap.String("response", string(respBody)),
 	)
-	logEntry.Status = "error"
+	logEntry.Status = models.StoryStatusError
 	logEntry.Error = "Unknown error: no post ID returned"
 	_, _ = s.storiesRepository.Create(ctx, logEntry)
-	return "", fmt.Errorf(logEntry.Error)
+	return nil, errors.New(logEntry.Error)
+}
+
+// publishViaPostsAPI publishes text through the versioned Posts API and records the attempt
+func (s *postService) publishViaPostsAPI(ctx context.Context, userID primitive.ObjectID, origin publishOrigin, accessToken, personUrn, text string) (*models.SocialPostStories, error) {
+	createdAt := time.Now().UTC()
+	logEntry := &models.SocialPostStories{
+		UserID:              userID,
+		PostGenerationLogID: origin.PostLogID,
+		DraftID:             origin.DraftID,
+		OriginalStoryID:     origin.OriginalStoryID,
+		Network:             models.NetworkLinkedIn,
+		PostContent:         text,
+		Payload:             newPostPayload(personUrn, text),
+		CreatedAt:           createdAt,
+		UpdatedAt:           createdAt,
+		Status:              models.StoryStatusStarted,
+	}
+
+	startTime := time.Now()
+	postURN, err := s.linkedInClient.CreatePost(ctx, accessToken, logEntry.Payload)
+	duration := time.Since(startTime)
+	logEntry.UpdatedAt = time.Now().UTC()
+
+	if err != nil {
+		logEntry.Status = models.StoryStatusError
+		logEntry.Error = err.Error()
+		_, _ = s.storiesRepository.Create(ctx, logEntry)
+		return nil, err
+	}
+
+	log.Logger.Info("LinkedIn post published successfully",
+		zap.String("linkedinPostId", postURN),
+		zap.Duration("totalDuration", duration),
+	)
+	logEntry.Status = models.StoryStatusSuccess
+	logEntry.ExternalPostID = postURN
+	logEntry.ID, _ = s.storiesRepository.Create(ctx, logEntry)
+	return logEntry, nil
 }
 
 func (s *postService) ListPosts(ctx context.Context, userId primitive.ObjectID, limit int) ([]models.PostGenerationLog, error) {
@@ -339,18 +1093,37 @@ func (s *postService) DeleteLinkedInPost(ctx context.Context, userID primitive.O
 		zap.String("externalPostId", externalPostID),
 	)
 
+	if postLogID != primitive.NilObjectID {
+		postLog, err := s.logRepository.GetByID(ctx, postLogID)
+		if err != nil {
+			return err
+		}
+		if postLog == nil || postLog.UserID != userID {
+			return ErrPostNotFound
+		}
+		if !postLog.Status.CanTransitionTo(models.PostStatusDeleted) {
+			return fmt.Errorf("%w: cannot delete a post that is %s", ErrInvalidStatusTransition, postLog.Status)
+		}
+	}
+
 	resolvedPostID, err := s.resolveExternalPostID(ctx, postLogID, externalPostID)
 	if err != nil {
 		return err
 	}
 
-	if err := s.callLinkedInDeleteAPI(accessToken, resolvedPostID); err != nil {
+	if err := s.linkedInClient.DeletePost(ctx, accessToken, resolvedPostID); err != nil {
 		return err
 	}
 
 	log.Logger.Info("LinkedIn post deleted successfully", zap.String("externalPostId", resolvedPostID))
 
-	s.markPostAsDeleted(ctx, postLogID)
+	s.markPostAsDeleted(ctx, userID, postLogID)
+
+	data := map[string]interface{}{"network": models.NetworkLinkedIn, "externalPostId": resolvedPostID}
+	if postLogID != primitive.NilObjectID {
+		data["postLogId"] = postLogID.Hex()
+	}
+	s.emit(ctx, userID, models.WebhookPostDeleted, data)
 	return nil
 }
 
@@ -363,7 +1136,7 @@ func (s *postService) resolveExternalPostID(ctx context.Context, postLogID primi
 		return "", fmt.Errorf("no LinkedIn post ID found")
 	}
 
-	story, err := s.storiesRepository.GetByPostLogID(ctx, postLogID)
+	story, err := s.storiesRepository.GetPublishedByPostLogID(ctx, postLogID, models.NetworkLinkedIn)
 	if err != nil {
 		log.Logger.Error("Failed to get social post story", zap.Error(err))
 		return "", fmt.Errorf("failed to find post: %w", err)
@@ -377,54 +1150,24 @@ func (s *postService) resolveExternalPostID(ctx context.Context, postLogID primi
 	return story.ExternalPostID, nil
 }
 
-func (s *postService) callLinkedInDeleteAPI(accessToken, externalPostID string) error {
-	url := fmt.Sprintf("https://api.linkedin.com/v2/ugcPosts/%s", externalPostID)
-
-	req, err := http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		log.Logger.Error("Failed to create LinkedIn delete request", zap.Error(err))
-		return err
-	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("X-Restli-Protocol-Version", "2.0.0")
-
-	client := &http.Client{Timeout: 10 * time.Second}
-	resp, err := client.Do(req)
-	if err != nil {
-		log.Logger.Error("LinkedIn delete request failed", zap.Error(err))
-		return err
-	}
-	defer resp.Body.Close()
-
-	return s.handleLinkedInDeleteResponse(resp)
-}
-
-func (s *postService) handleLinkedInDeleteResponse(resp *http.Response) error {
-	if resp.StatusCode == 401 || resp.StatusCode == 403 {
-		log.Logger.Warn("LinkedIn token expired or invalid for deletion", zap.Int("statusCode", resp.StatusCode))
-		return fmt.Errorf("LinkedIn token expired or invalid. Please reconnect your LinkedIn account")
+func (s *postService) markPostAsDeleted(ctx context.Context, actorID, postLogID primitive.ObjectID) {
+	if postLogID == primitive.NilObjectID {
+		return
 	}
 
-	if resp.StatusCode != 204 && resp.StatusCode != 200 {
-		respBody, _ := io.ReadAll(resp.Body)
-		log.Logger.Error("LinkedIn delete API error", zap.Int("statusCode", resp.StatusCode), zap.String("response", string(respBody)))
-		return fmt.Errorf("LinkedIn API error: %s", string(respBody))
-	}
-
-	return nil
-}
-
-func (s *postService) markPostAsDeleted(ctx context.Context, postLogID primitive.ObjectID) {
-	if postLogID != primitive.NilObjectID {
-		_ = s.logRepository.UpdateByID(ctx, postLogID, bson.M{
-			"$set": bson.M{"status": "deleted"},
-		})
+	if err := s.transitionPost(ctx, postLogID, actorID, models.PostStatusDeleted, "deleted on LinkedIn", nil); err != nil {
+		log.Logger.Warn("Post not marked as deleted", zap.String("postLogId", postLogID.Hex()), zap.Error(err))
 	}
 
 	if s.storiesRepository != nil {
-		story, _ := s.storiesRepository.GetByPostLogID(ctx, postLogID)
+		story, _ := s.storiesRepository.GetPublishedByPostLogID(ctx, postLogID, models.NetworkLinkedIn)
 		if story != nil {
-			_ = s.storiesRepository.UpdateStatus(ctx, story.ID, "deleted")
+			_, _ = s.storiesRepository.TransitionStatus(ctx, story.ID, models.StatusTransition{
+				From:    string(story.Status),
+				To:      string(models.StoryStatusDeleted),
+				ActorID: actorID,
+				At:      time.Now().UTC(),
+			})
 		}
 	}
 }
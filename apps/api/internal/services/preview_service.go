@@ -0,0 +1,379 @@
+package services
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/postpilot/api/internal/models"
+)
+
+// NetworkX is only used for previews: posts cannot be published to X yet
+const NetworkX = "x"
+
+// PreviewNetworks lists the networks a preview can be rendered for
+var PreviewNetworks = []string{models.NetworkLinkedIn, NetworkX}
+
+const (
+	linkedInCharacterLimit = 3000
+	// LinkedIn collapses the feed text after about 210 characters or 3 lines, whichever comes first
+	linkedInFoldCharacters = 210
+	linkedInFoldLines      = 3
+
+	xCharacterLimit = 280
+	// X counts every URL as 23 characters, whatever its length
+	xURLLength = 23
+)
+
+// PreviewSegment is a piece of the post text as the network renders it
+type PreviewSegment struct {
+	Type string `json:"type"` // text, hashtag, mention, link
+	Text string `json:"text"`
+	Href string `json:"href,omitempty"`
+}
+
+// LinkCardPreview is the link preview card a network attaches to a post
+type LinkCardPreview struct {
+	URL      string `json:"url"`
+	Domain   string `json:"domain"`
+	Position string `json:"position"` // below_text
+}
+
+// NetworkPreview describes how a text will appear on one network
+type NetworkPreview struct {
+	Network        string           `json:"network"`
+	CharacterCount int              `json:"characterCount"`
+	CharacterLimit int              `json:"characterLimit"`
+	WithinLimit    bool             `json:"withinLimit"`
+	Truncated      bool             `json:"truncated"`
+	VisibleText    string           `json:"visibleText"`
+	Hashtags       []string         `json:"hashtags"`
+	Mentions       []string         `json:"mentions"`
+	Links          []string         `json:"links"`
+	MentionsLinked bool             `json:"mentionsLinked"`
+	LinkCard       *LinkCardPreview `json:"linkCard,omitempty"`
+	Segments       []PreviewSegment `json:"segments"`
+	HTML           string           `json:"html"`
+}
+
+type PreviewService interface {
+	Preview(text string, networks []string) []NetworkPreview
+}
+
+type previewService struct{}
+
+func NewPreviewService() PreviewService {
+	return &previewService{}
+}
+
+// Preview renders text for each network, in the order given. Unknown networks are skipped.
+func (s *previewService) Preview(text string, networks []string) []NetworkPreview {
+	if len(networks) == 0 {
+		networks = PreviewNetworks
+	}
+	previews := make([]NetworkPreview, 0, len(networks))
+	for _, network := range networks {
+		switch network {
+		case models.NetworkLinkedIn:
+			previews = append(previews, previewLinkedIn(text))
+		case NetworkX:
+			previews = append(previews, previewX(text))
+		}
+	}
+	return previews
+}
+
+func previewLinkedIn(text string) NetworkPreview {
+	tokens := tokenizePost(text)
+	preview := NetworkPreview{
+		Network:        models.NetworkLinkedIn,
+		CharacterCount: utf8.RuneCountInString(text),
+		CharacterLimit: linkedInCharacterLimit,
+		// Mentions are only linked when made through the mention picker; typed @names stay plain text
+		MentionsLinked: false,
+	}
+	preview.WithinLimit = preview.CharacterCount <= linkedInCharacterLimit
+	fillTokenLists(&preview, tokens)
+
+	// LinkedIn shows a card for the first link when the post has no media
+	if len(preview.Links) > 0 {
+		preview.LinkCard = newLinkCard(preview.Links[0])
+	}
+
+	fold := linkedInFoldIndex(text)
+	preview.Truncated = fold < len(text)
+	preview.VisibleText = strings.TrimRight(text[:fold], " \n")
+	preview.Segments = buildSegments(text, tokens, false)
+	preview.HTML = renderPreviewHTML(&preview, text, tokens, fold, "…ver mais")
+	return preview
+}
+
+func previewX(text string) NetworkPreview {
+	tokens := tokenizePost(text)
+	preview := NetworkPreview{
+		Network:        NetworkX,
+		CharacterCount: xWeightedLength(text, tokens),
+		CharacterLimit: xCharacterLimit,
+		MentionsLinked: true,
+	}
+	preview.WithinLimit = preview.CharacterCount <= xCharacterLimit
+	fillTokenLists(&preview, tokens)
+
+	// X shows a card for the last link of the post
+	if len(preview.Links) > 0 {
+		preview.LinkCard = newLinkCard(preview.Links[len(preview.Links)-1])
+	}
+
+	// X does not fold short posts; text past the limit is what would be rejected
+	fold := len(text)
+	if !preview.WithinLimit {
+		fold = xLimitIndex(text, tokens)
+		preview.Truncated = true
+	}
+	preview.VisibleText = text[:fold]
+	preview.Segments = buildSegments(text, tokens, true)
+	preview.HTML = renderPreviewHTML(&preview, text, tokens, fold, "")
+	return preview
+}
+
+// postToken is a hashtag, mention or link found in the text, with byte offsets
+type postToken struct {
+	Type  string
+	Start int
+	End   int
+	Value string
+}
+
+var (
+	previewURLRegex     = regexp.MustCompile(`https?://[^\s<>"]+`)
+	previewMentionRegex = regexp.MustCompile(`(^|[^\p{L}\p{N}_@])@([\p{L}\p{N}_]{1,30})`)
+)
+
+// tokenizePost finds links, hashtags and mentions. Hashtags and mentions inside links are ignored.
+func tokenizePost(text string) []postToken {
+	var tokens []postToken
+	for _, m := range findLinks(text) {
+		tokens = append(tokens, postToken{Type: "link", Start: m[0], End: m[1], Value: text[m[0]:m[1]]})
+	}
+
+	insideLink := func(pos int) bool {
+		for _, t := range tokens {
+			if t.Type == "link" && pos >= t.Start && pos < t.End {
+				return true
+			}
+		}
+		return false
+	}
+	for _, m := range hashtagRegex.FindAllStringSubmatchIndex(text, -1) {
+		start := m[4] - 1
+		if !insideLink(start) {
+			tokens = append(tokens, postToken{Type: "hashtag", Start: start, End: m[5], Value: text[m[4]:m[5]]})
+		}
+	}
+	for _, m := range previewMentionRegex.FindAllStringSubmatchIndex(text, -1) {
+		start := m[4] - 1
+		if !insideLink(start) {
+			tokens = append(tokens, postToken{Type: "mention", Start: start, End: m[5], Value: text[m[4]:m[5]]})
+		}
+	}
+
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Start < tokens[j].Start })
+	return tokens
+}
+
+func fillTokenLists(preview *NetworkPreview, tokens []postToken) {
+	preview.Hashtags = []string{}
+	preview.Mentions = []string{}
+	preview.Links = []string{}
+	for _, t := range tokens {
+		switch t.Type {
+		case "hashtag":
+			preview.Hashtags = append(preview.Hashtags, t.Value)
+		case "mention":
+			preview.Mentions = append(preview.Mentions, t.Value)
+		case "link":
+			preview.Links = append(preview.Links, t.Value)
+		}
+	}
+}
+
+func newLinkCard(link string) *LinkCardPreview {
+	card := &LinkCardPreview{URL: link, Position: "below_text"}
+	if u, err := url.Parse(link); err == nil {
+		card.Domain = strings.TrimPrefix(u.Hostname(), "www.")
+	}
+	return card
+}
+
+// buildSegments splits text into plain and rendered segments. Mentions only become
+// links on networks that link typed @names.
+func buildSegments(text string, tokens []postToken, linkMentions bool) []PreviewSegment {
+	segments := []PreviewSegment{}
+	last := 0
+	for _, t := range tokens {
+		if t.Start > last {
+			segments = append(segments, PreviewSegment{Type: "text", Text: text[last:t.Start]})
+		}
+		seg := PreviewSegment{Type: t.Type, Text: text[t.Start:t.End]}
+		switch t.Type {
+		case "link":
+			seg.Href = t.Value
+		case "mention":
+			if !linkMentions {
+				seg.Type = "text"
+			}
+		}
+		segments = append(segments, seg)
+		last = t.End
+	}
+	if last < len(text) {
+		segments = append(segments, PreviewSegment{Type: "text", Text: text[last:]})
+	}
+	return segments
+}
+
+// linkedInFoldIndex returns the byte offset where LinkedIn cuts the feed text, or len(text) if it fits
+func linkedInFoldIndex(text string) int {
+	lines := 0
+	chars := 0
+	for i, r := range text {
+		if r == '\n' {
+			lines++
+			if lines >= linkedInFoldLines {
+				return i
+			}
+		}
+		chars++
+		if chars > linkedInFoldCharacters {
+			// Cut at the last word boundary before the limit, like the feed does
+			if space := strings.LastIndexAny(text[:i], " \n"); space > 0 {
+				return space
+			}
+			return i
+		}
+	}
+	return len(text)
+}
+
+// xWeightedLength counts text the way X does: URLs count 23, most Latin and punctuation
+// characters count 1 and everything else, CJK and emoji included, counts 2. Emoji
+// modifiers and joiners add nothing, so an emoji sequence counts 2 like X does.
+func xWeightedLength(text string, tokens []postToken) int {
+	length := 0
+	last := 0
+	for _, t := range tokens {
+		if t.Type != "link" {
+			continue
+		}
+		length += xTextWeight(text[last:t.Start]) + xURLLength
+		last = t.End
+	}
+	return length + xTextWeight(text[last:])
+}
+
+// xLimitIndex returns the byte offset after which the text goes over the X limit
+func xLimitIndex(text string, tokens []postToken) int {
+	length := 0
+	i := 0
+	for i < len(text) {
+		var next, weight int
+		if t := tokenAt(tokens, i); t != nil && t.Type == "link" {
+			next, weight = t.End, xURLLength
+		} else {
+			r, size := utf8.DecodeRuneInString(text[i:])
+			next, weight = i+size, xRuneWeight(r)
+		}
+		if length+weight > xCharacterLimit {
+			return i
+		}
+		length += weight
+		i = next
+	}
+	return len(text)
+}
+
+func tokenAt(tokens []postToken, pos int) *postToken {
+	for i := range tokens {
+		if tokens[i].Start == pos {
+			return &tokens[i]
+		}
+	}
+	return nil
+}
+
+func xTextWeight(text string) int {
+	weight := 0
+	for _, r := range text {
+		weight += xRuneWeight(r)
+	}
+	return weight
+}
+
+func xRuneWeight(r rune) int {
+	switch {
+	case r == 0x200D, r >= 0xFE00 && r <= 0xFE0F, r >= 0x1F3FB && r <= 0x1F3FF:
+		return 0
+	case r <= 0x10FF, r >= 0x2000 && r <= 0x200C, r >= 0x2010 && r <= 0x201F, r >= 0x2032 && r <= 0x2037:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// renderPreviewHTML renders the visible part of the text as an embeddable snippet. Text after
+// fold is hidden behind moreLabel, or dropped when the network has no "see more".
+func renderPreviewHTML(preview *NetworkPreview, text string, tokens []postToken, fold int, moreLabel string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, `<div class="pp-preview pp-preview--%s">`, preview.Network)
+	b.WriteString(`<p class="pp-preview__text">`)
+
+	last := 0
+	for _, t := range tokens {
+		if t.Start >= fold {
+			break
+		}
+		b.WriteString(htmlText(text[last:t.Start]))
+		end := t.End
+		if end > fold {
+			end = fold
+		}
+		token := htmlText(text[t.Start:end])
+		switch {
+		case t.Type == "link":
+			fmt.Fprintf(&b, `<a class="pp-link" href="%s" rel="nofollow noopener" target="_blank">%s</a>`, html.EscapeString(t.Value), token)
+		case t.Type == "mention" && !preview.MentionsLinked:
+			b.WriteString(token)
+		default:
+			fmt.Fprintf(&b, `<span class="pp-%s">%s</span>`, t.Type, token)
+		}
+		last = end
+	}
+	if last < fold {
+		b.WriteString(htmlText(text[last:fold]))
+	}
+
+	if preview.Truncated && moreLabel != "" {
+		fmt.Fprintf(&b, ` <span class="pp-see-more">%s</span>`, html.EscapeString(moreLabel))
+	}
+	b.WriteString(`</p>`)
+
+	if preview.LinkCard != nil {
+		fmt.Fprintf(&b, `<a class="pp-link-card" href="%s" rel="nofollow noopener" target="_blank"><span class="pp-link-card__domain">%s</span></a>`,
+			html.EscapeString(preview.LinkCard.URL), html.EscapeString(preview.LinkCard.Domain))
+	}
+	countClass := "pp-preview__count"
+	if !preview.WithinLimit {
+		countClass += " pp-preview__count--over"
+	}
+	fmt.Fprintf(&b, `<div class="%s">%d/%d</div>`, countClass, preview.CharacterCount, preview.CharacterLimit)
+	b.WriteString(`</div>`)
+	return b.String()
+}
+
+func htmlText(text string) string {
+	return strings.ReplaceAll(html.EscapeString(text), "\n", "<br>")
+}
@@ -0,0 +1,290 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrPostNotApproved         = errors.New("post must be approved before it can be published")
+	ErrReviewNotAllowed        = errors.New("you are not allowed to perform this review action")
+	ErrInvalidReviewTransition = errors.New("invalid review transition")
+	ErrReviewCommentRequired   = errors.New("a comment is required when requesting changes")
+	ErrReviewWorkflowDisabled  = errors.New("approval workflow is not enabled for this account")
+	ErrReviewersRequired       = errors.New("at least one reviewer is required to enable the approval workflow")
+	ErrReviewerNotFound        = errors.New("reviewer not found")
+	ErrSelfReview              = errors.New("you cannot review your own posts")
+	ErrReviewDisableNotAllowed = errors.New("the approval workflow can only be disabled by one of your reviewers")
+)
+
+type ReviewAction string
+
+const (
+	ReviewActionSubmit         ReviewAction = "submit"
+	ReviewActionApprove        ReviewAction = "approve"
+	ReviewActionRequestChanges ReviewAction = "request_changes"
+)
+
+// reviewRule describes which statuses an action applies to, where it leads and who may perform it
+type reviewRule struct {
+	from         []models.ReviewStatus
+	to           models.ReviewStatus
+	reviewerOnly bool
+}
+
+var reviewRules = map[ReviewAction]reviewRule{
+	ReviewActionSubmit: {
+		from: []models.ReviewStatus{models.ReviewStatusDraft, models.ReviewStatusChangesRequested},
+		to:   models.ReviewStatusInReview,
+	},
+	ReviewActionApprove: {
+		from:         []models.ReviewStatus{models.ReviewStatusInReview},
+		to:           models.ReviewStatusApproved,
+		reviewerOnly: true,
+	},
+	ReviewActionRequestChanges: {
+		from:         []models.ReviewStatus{models.ReviewStatusInReview, models.ReviewStatusApproved},
+		to:           models.ReviewStatusChangesRequested,
+		reviewerOnly: true,
+	},
+}
+
+type ReviewService interface {
+	UpdateSettings(ctx context.Context, user *models.User, enabled bool, reviewerEmails []string) (*models.ReviewSettings, error)
+	Transition(ctx context.Context, actor *models.User, postLogID primitive.ObjectID, action ReviewAction, comment string) (*models.PostGenerationLog, error)
+	ListQueue(ctx context.Context, reviewerID primitive.ObjectID, limit int) ([]models.PostGenerationLog, error)
+	DisableForAuthor(ctx context.Context, reviewer *models.User, authorID primitive.ObjectID) (*models.ReviewSettings, error)
+}
+
+type reviewService struct {
+	logRepository       repositories.PostGenerationLogRepository
+	userRepository      repositories.UserRepository
+	notificationService NotificationService
+}
+
+func NewReviewService(
+	logRepo repositories.PostGenerationLogRepository,
+	userRepo repositories.UserRepository,
+	notificationService NotificationService,
+) ReviewService {
+	return &reviewService{
+		logRepository:       logRepo,
+		userRepository:      userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// UpdateSettings enables the approval workflow and assigns its reviewers by email. Once enabled, the author cannot
+// disable it, only one of the reviewers through DisableForAuthor.
+func (s *reviewService) UpdateSettings(ctx context.Context, user *models.User, enabled bool, reviewerEmails []string) (*models.ReviewSettings, error) {
+	if !enabled && user.RequiresApproval() {
+		return nil, ErrReviewDisableNotAllowed
+	}
+	settings := &models.ReviewSettings{Enabled: enabled, ReviewerIDs: []primitive.ObjectID{}}
+
+	for _, email := range reviewerEmails {
+		reviewer, err := s.userRepository.FindByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+		if err != nil {
+			return nil, err
+		}
+		if reviewer == nil {
+			return nil, fmt.Errorf("%w: %s", ErrReviewerNotFound, email)
+		}
+		if reviewer.ID == user.ID {
+			return nil, ErrSelfReview
+		}
+		settings.ReviewerIDs = append(settings.ReviewerIDs, reviewer.ID)
+	}
+	if enabled && len(settings.ReviewerIDs) == 0 {
+		return nil, ErrReviewersRequired
+	}
+
+	if err := s.userRepository.SetReviewSettings(ctx, user.ID, settings); err != nil {
+		return nil, err
+	}
+
+	log.Logger.Info("Review settings updated",
+		zap.String("userId", user.ID.Hex()),
+		zap.Bool("enabled", enabled),
+		zap.Int("reviewers", len(settings.ReviewerIDs)),
+	)
+	return settings, nil
+}
+
+// DisableForAuthor turns off the approval workflow of an author on behalf of one of their reviewers. The reviewers
+// stay assigned, so the author can enable the workflow again without reassigning them.
+func (s *reviewService) DisableForAuthor(ctx context.Context, reviewer *models.User, authorID primitive.ObjectID) (*models.ReviewSettings, error) {
+	author, err := s.userRepository.FindByID(ctx, authorID.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if author == nil || !author.IsReviewer(reviewer.ID) {
+		return nil, ErrReviewNotAllowed
+	}
+	if !author.RequiresApproval() {
+		return nil, ErrReviewWorkflowDisabled
+	}
+
+	settings := &models.ReviewSettings{Enabled: false, ReviewerIDs: author.Review.ReviewerIDs}
+	if err := s.userRepository.SetReviewSettings(ctx, author.ID, settings); err != nil {
+		return nil, err
+	}
+
+	log.Logger.Info("Review workflow disabled by reviewer",
+		zap.String("userId", author.ID.Hex()),
+		zap.String("reviewerId", reviewer.ID.Hex()),
+	)
+	s.notificationService.Notify(ctx, &models.Notification{
+		UserID:  author.ID,
+		Type:    models.NotificationReviewDisabled,
+		Message: fmt.Sprintf("%s desativou o fluxo de aprovação dos seus posts", reviewer.Name),
+		ActorID: reviewer.ID,
+	})
+	return settings, nil
+}
+
+// Transition applies a review action to a post and notifies the other side of the review
+func (s *reviewService) Transition(ctx context.Context, actor *models.User, postLogID primitive.ObjectID, action ReviewAction, comment string) (*models.PostGenerationLog, error) {
+	rule, ok := reviewRules[action]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown action %q", ErrInvalidReviewTransition, action)
+	}
+
+	postLog, err := s.logRepository.GetByID(ctx, postLogID)
+	if err != nil {
+		return nil, err
+	}
+	if postLog == nil {
+		return nil, ErrPostNotFound
+	}
+
+	author := actor
+	if postLog.UserID != actor.ID {
+		author, err = s.userRepository.FindByID(ctx, postLog.UserID.Hex())
+		if err != nil {
+			return nil, err
+		}
+		// Posts are only visible to their author and the author's reviewers
+		if author == nil || !author.IsReviewer(actor.ID) {
+			return nil, ErrPostNotFound
+		}
+	}
+
+	isReviewer := postLog.UserID != actor.ID
+	if rule.reviewerOnly != isReviewer {
+		return nil, ErrReviewNotAllowed
+	}
+	if !author.RequiresApproval() {
+		return nil, ErrReviewWorkflowDisabled
+	}
+	if action == ReviewActionRequestChanges && strings.TrimSpace(comment) == "" {
+		return nil, ErrReviewCommentRequired
+	}
+
+	from := postLog.CurrentReviewStatus()
+	if !containsReviewStatus(rule.from, from) {
+		return nil, fmt.Errorf("%w: cannot %s a post that is %s", ErrInvalidReviewTransition, action, from)
+	}
+
+	event := models.ReviewEvent{
+		From:    from,
+		To:      rule.to,
+		ActorID: actor.ID,
+		Comment: strings.TrimSpace(comment),
+		At:      time.Now().UTC(),
+	}
+	updated, err := s.logRepository.TransitionReview(ctx, postLogID, from, event)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, fmt.Errorf("%w: post status changed, reload and try again", ErrInvalidReviewTransition)
+	}
+
+	log.Logger.Info("Post review transitioned",
+		zap.String("postLogId", postLogID.Hex()),
+		zap.String("actorId", actor.ID.Hex()),
+		zap.String("from", string(from)),
+		zap.String("to", string(rule.to)),
+	)
+
+	s.notifyTransition(ctx, actor, author, postLog, event)
+
+	postLog.ReviewStatus = event.To
+	postLog.ReviewHistory = append(postLog.ReviewHistory, event)
+	return postLog, nil
+}
+
+func (s *reviewService) notifyTransition(ctx context.Context, actor, author *models.User, postLog *models.PostGenerationLog, event models.ReviewEvent) {
+	switch event.To {
+	case models.ReviewStatusInReview:
+		for _, reviewerID := range author.Review.ReviewerIDs {
+			s.notificationService.Notify(ctx, &models.Notification{
+				UserID:              reviewerID,
+				Type:                models.NotificationReviewRequested,
+				Message:             fmt.Sprintf("%s pediu sua revisão de um post", author.Name),
+				PostGenerationLogID: postLog.ID,
+				ActorID:             actor.ID,
+			})
+		}
+	case models.ReviewStatusApproved:
+		s.notificationService.Notify(ctx, &models.Notification{
+			UserID:              author.ID,
+			Type:                models.NotificationPostApproved,
+			Message:             fmt.Sprintf("%s aprovou seu post", actor.Name),
+			PostGenerationLogID: postLog.ID,
+			ActorID:             actor.ID,
+		})
+	case models.ReviewStatusChangesRequested:
+		s.notificationService.Notify(ctx, &models.Notification{
+			UserID:              author.ID,
+			Type:                models.NotificationChangesRequested,
+			Message:             fmt.Sprintf("%s pediu alterações no seu post: %s", actor.Name, event.Comment),
+			PostGenerationLogID: postLog.ID,
+			ActorID:             actor.ID,
+		})
+	}
+}
+
+// ListQueue returns the posts waiting for review by the given reviewer, oldest first
+func (s *reviewService) ListQueue(ctx context.Context, reviewerID primitive.ObjectID, limit int) ([]models.PostGenerationLog, error) {
+	authors, err := s.userRepository.ListByReviewer(ctx, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(authors) == 0 {
+		return []models.PostGenerationLog{}, nil
+	}
+
+	authorIDs := make([]primitive.ObjectID, len(authors))
+	for i, author := range authors {
+		authorIDs[i] = author.ID
+	}
+
+	posts, err := s.logRepository.ListByReviewStatus(ctx, authorIDs, models.ReviewStatusInReview, limit)
+	if err != nil {
+		return nil, err
+	}
+	if posts == nil {
+		posts = []models.PostGenerationLog{}
+	}
+	return posts, nil
+}
+
+func containsReviewStatus(statuses []models.ReviewStatus, status models.ReviewStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
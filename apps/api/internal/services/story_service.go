@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrStoryNotFound     = errors.New("published post not found")
+	ErrStoryNotEditable  = errors.New("only successfully published LinkedIn posts can be edited")
+	ErrStoryEditConflict = errors.New("published post was edited by another request")
+)
+
+type StoryService interface {
+	EditPublishedPost(ctx context.Context, user *models.User, storyID primitive.ObjectID, text string) (*models.SocialPostStories, error)
+	ListRevisions(ctx context.Context, userID, storyID primitive.ObjectID) (*StoryRevisionsResponse, error)
+}
+
+type storyService struct {
+	storiesRepository repositories.SocialPostStoriesRepository
+	linkedInClient    *LinkedInClient
+}
+
+func NewStoryService(storiesRepo repositories.SocialPostStoriesRepository, linkedInClient *LinkedInClient) StoryService {
+	return &storyService{storiesRepository: storiesRepo, linkedInClient: linkedInClient}
+}
+
+// StoryVersion is one version of a published post's text
+type StoryVersion struct {
+	Version    int        `json:"version"`
+	Text       string     `json:"text"`
+	LiveFrom   time.Time  `json:"liveFrom"`
+	ReplacedAt *time.Time `json:"replacedAt,omitempty"`
+	Current    bool       `json:"current"`
+	Diff       []DiffOp   `json:"diff,omitempty"` // changes from the previous version
+}
+
+type StoryRevisionsResponse struct {
+	StoryID  string         `json:"storyId"`
+	Versions []StoryVersion `json:"versions"`
+}
+
+// EditPublishedPost updates the text of a published post on the network and keeps the previous text as a revision
+func (s *storyService) EditPublishedPost(ctx context.Context, user *models.User, storyID primitive.ObjectID, text string) (*models.SocialPostStories, error) {
+	story, err := s.getUserStory(ctx, user.ID, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if story.Network != models.NetworkLinkedIn || story.Status != models.StoryStatusSuccess || story.ExternalPostID == "" {
+		return nil, ErrStoryNotEditable
+	}
+	if story.PostContent == text {
+		return story, nil
+	}
+	if user.LinkedinAccessToken == "" {
+		return nil, ErrLinkedInNotConnected
+	}
+
+	log.Logger.Info("Editing published LinkedIn post",
+		zap.String("userId", user.ID.Hex()),
+		zap.String("storyId", storyID.Hex()),
+		zap.String("externalPostId", story.ExternalPostID),
+		zap.Int("textLength", len(text)),
+	)
+
+	// The revision is stored first, guarded on the text read above, so of two concurrent edits only one
+	// reaches LinkedIn and neither loses the text it replaced
+	now := time.Now().UTC()
+	revision := models.PostRevision{
+		Text:       story.PostContent,
+		LiveFrom:   currentVersionLiveFrom(story),
+		ReplacedAt: now,
+	}
+	applied, err := s.storiesRepository.AppendRevision(ctx, storyID, revision, text)
+	if err != nil {
+		return nil, err
+	}
+	if !applied {
+		return nil, ErrStoryEditConflict
+	}
+
+	if err := s.linkedInClient.UpdatePostCommentary(ctx, user.LinkedinAccessToken, story.ExternalPostID, text); err != nil {
+		if revertErr := s.storiesRepository.RevertRevision(ctx, storyID, revision, text, story.EditedAt); revertErr != nil {
+			log.Logger.Error("Failed to revert revision of unsent edit", zap.String("storyId", storyID.Hex()), zap.Error(revertErr))
+		}
+		return nil, err
+	}
+
+	story.Revisions = append(story.Revisions, revision)
+	story.PostContent = text
+	story.EditedAt = &now
+	story.UpdatedAt = now
+	return story, nil
+}
+
+// ListRevisions returns every version of a published post, oldest first, each with a diff against the previous one
+func (s *storyService) ListRevisions(ctx context.Context, userID, storyID primitive.ObjectID) (*StoryRevisionsResponse, error) {
+	story, err := s.getUserStory(ctx, userID, storyID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]StoryVersion, 0, len(story.Revisions)+1)
+	previous := ""
+	for i, rev := range story.Revisions {
+		replacedAt := rev.ReplacedAt
+		version := StoryVersion{
+			Version:    i + 1,
+			Text:       rev.Text,
+			LiveFrom:   rev.LiveFrom,
+			ReplacedAt: &replacedAt,
+		}
+		if i > 0 {
+			version.Diff = DiffWords(previous, rev.Text)
+		}
+		versions = append(versions, version)
+		previous = rev.Text
+	}
+
+	current := StoryVersion{
+		Version:  len(story.Revisions) + 1,
+		Text:     story.PostContent,
+		LiveFrom: currentVersionLiveFrom(story),
+		Current:  true,
+	}
+	if len(story.Revisions) > 0 {
+		current.Diff = DiffWords(previous, story.PostContent)
+	}
+	versions = append(versions, current)
+
+	return &StoryRevisionsResponse{StoryID: storyID.Hex(), Versions: versions}, nil
+}
+
+func (s *storyService) getUserStory(ctx context.Context, userID, storyID primitive.ObjectID) (*models.SocialPostStories, error) {
+	story, err := s.storiesRepository.GetByID(ctx, storyID)
+	if err != nil {
+		return nil, err
+	}
+	if story == nil || story.UserID != userID {
+		return nil, ErrStoryNotFound
+	}
+	return story, nil
+}
+
+// currentVersionLiveFrom returns when the current text of a story went live
+func currentVersionLiveFrom(story *models.SocialPostStories) time.Time {
+	if story.EditedAt != nil {
+		return *story.EditedAt
+	}
+	return story.CreatedAt
+}
@@ -0,0 +1,66 @@
+package services
+
+import "regexp"
+
+// DiffOp is a run of text that was kept, inserted or deleted between two versions
+type DiffOp struct {
+	Op   string `json:"op"` // equal, insert, delete
+	Text string `json:"text"`
+}
+
+var diffTokenRegex = regexp.MustCompile(`\s+|[^\s]+`)
+
+// DiffWords computes a word-level diff between two texts using the longest common subsequence
+func DiffWords(oldText, newText string) []DiffOp {
+	a := diffTokenRegex.FindAllString(oldText, -1)
+	b := diffTokenRegex.FindAllString(newText, -1)
+
+	// lcs[i][j] holds the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []DiffOp
+	appendOp := func(op, text string) {
+		if n := len(ops); n > 0 && ops[n-1].Op == op {
+			ops[n-1].Text += text
+			return
+		}
+		ops = append(ops, DiffOp{Op: op, Text: text})
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			appendOp("equal", a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			appendOp("delete", a[i])
+			i++
+		default:
+			appendOp("insert", b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		appendOp("delete", a[i])
+	}
+	for ; j < len(b); j++ {
+		appendOp("insert", b[j])
+	}
+	return ops
+}
@@ -0,0 +1,430 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/postpilot/api/internal/config"
+	"github.com/postpilot/api/internal/httpclient"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"github.com/postpilot/api/internal/repositories"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrWebhookNotFound   = errors.New("webhook not found")
+	ErrInvalidWebhookURL = errors.New("invalid webhook url")
+)
+
+const (
+	webhookSignatureHeader = "X-PostPilot-Signature"
+	webhookTimestampHeader = "X-PostPilot-Timestamp"
+	webhookEventHeader     = "X-PostPilot-Event"
+	webhookDeliveryHeader  = "X-PostPilot-Delivery"
+
+	// webhookClaimLease is how long a delivery being sent is hidden from other workers
+	webhookClaimLease = 2 * time.Minute
+	// webhookRetryBatchSize bounds how many deliveries one run of the retry job sends
+	webhookRetryBatchSize = 100
+)
+
+// WebhookInput holds the fields of a new webhook
+type WebhookInput struct {
+	URL         string
+	Description string
+	Events      []models.WebhookEvent
+}
+
+// WebhookPatch holds the fields to change on a webhook; nil fields are left untouched
+type WebhookPatch struct {
+	URL         *string
+	Description *string
+	Events      *[]models.WebhookEvent
+	Active      *bool
+}
+
+type WebhookService interface {
+	Create(ctx context.Context, userID primitive.ObjectID, input WebhookInput) (*models.Webhook, error)
+	List(ctx context.Context, userID primitive.ObjectID) ([]models.Webhook, error)
+	Update(ctx context.Context, userID, webhookID primitive.ObjectID, patch WebhookPatch) (*models.Webhook, error)
+	Delete(ctx context.Context, userID, webhookID primitive.ObjectID) error
+	ListDeliveries(ctx context.Context, userID, webhookID primitive.ObjectID, limit int) ([]models.WebhookDelivery, error)
+	SendTest(ctx context.Context, userID, webhookID primitive.ObjectID) (*models.WebhookDelivery, error)
+	Dispatch(ctx context.Context, userID primitive.ObjectID, event models.WebhookEvent, data interface{})
+	RetryDue(ctx context.Context) error
+}
+
+type webhookService struct {
+	webhookRepository  repositories.WebhookRepository
+	deliveryRepository repositories.WebhookDeliveryRepository
+	httpClient         *http.Client
+	maxAttempts        int
+	retryBaseDelay     time.Duration
+}
+
+func NewWebhookService(webhookRepo repositories.WebhookRepository, deliveryRepo repositories.WebhookDeliveryRepository) WebhookService {
+	cfg := config.Get().Webhooks
+	httpConfig := httpclient.DefaultConfig()
+	httpConfig.Timeout = cfg.Timeout
+	return &webhookService{
+		webhookRepository:  webhookRepo,
+		deliveryRepository: deliveryRepo,
+		httpClient:         httpclient.NewPublic(httpConfig).Client(),
+		maxAttempts:        cfg.MaxAttempts,
+		retryBaseDelay:     cfg.RetryBaseDelay,
+	}
+}
+
+// webhookPayload is the JSON body sent to webhook endpoints
+type webhookPayload struct {
+	ID        string              `json:"id"`
+	Event     models.WebhookEvent `json:"event"`
+	CreatedAt time.Time           `json:"createdAt"`
+	Data      interface{}         `json:"data"`
+}
+
+// Create stores a new active webhook with a freshly generated signing secret
+func (s *webhookService) Create(ctx context.Context, userID primitive.ObjectID, input WebhookInput) (*models.Webhook, error) {
+	if err := validateWebhookURL(input.URL); err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	webhook := &models.Webhook{
+		UserID:      userID,
+		URL:         input.URL,
+		Description: input.Description,
+		Events:      input.Events,
+		Secret:      secret,
+		Active:      true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	id, err := s.webhookRepository.Create(ctx, webhook)
+	if err != nil {
+		return nil, err
+	}
+	webhook.ID = id
+
+	log.Logger.Info("Webhook created", zap.String("userId", userID.Hex()), zap.String("webhookId", id.Hex()))
+	return webhook, nil
+}
+
+func (s *webhookService) List(ctx context.Context, userID primitive.ObjectID) ([]models.Webhook, error) {
+	webhooks, err := s.webhookRepository.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if webhooks == nil {
+		webhooks = []models.Webhook{}
+	}
+	return webhooks, nil
+}
+
+func (s *webhookService) Update(ctx context.Context, userID, webhookID primitive.ObjectID, patch WebhookPatch) (*models.Webhook, error) {
+	webhook, err := s.get(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	set := bson.M{}
+	if patch.URL != nil {
+		if err := validateWebhookURL(*patch.URL); err != nil {
+			return nil, err
+		}
+		set["url"] = *patch.URL
+	}
+	if patch.Description != nil {
+		set["description"] = *patch.Description
+	}
+	if patch.Events != nil {
+		set["events"] = *patch.Events
+	}
+	if patch.Active != nil {
+		set["active"] = *patch.Active
+	}
+	if len(set) == 0 {
+		return webhook, nil
+	}
+
+	updated, err := s.webhookRepository.Update(ctx, webhookID, set)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrWebhookNotFound
+	}
+	return updated, nil
+}
+
+func (s *webhookService) Delete(ctx context.Context, userID, webhookID primitive.ObjectID) error {
+	if _, err := s.get(ctx, userID, webhookID); err != nil {
+		return err
+	}
+	if err := s.webhookRepository.Delete(ctx, webhookID); err != nil {
+		return err
+	}
+	return s.deliveryRepository.DeleteByWebhook(ctx, webhookID)
+}
+
+func (s *webhookService) ListDeliveries(ctx context.Context, userID, webhookID primitive.ObjectID, limit int) ([]models.WebhookDelivery, error) {
+	if _, err := s.get(ctx, userID, webhookID); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.deliveryRepository.ListByWebhook(ctx, webhookID, limit)
+	if err != nil {
+		return nil, err
+	}
+	if deliveries == nil {
+		deliveries = []models.WebhookDelivery{}
+	}
+	return deliveries, nil
+}
+
+// SendTest sends a webhook.test event right away and returns the outcome. Test events are not retried.
+func (s *webhookService) SendTest(ctx context.Context, userID, webhookID primitive.ObjectID) (*models.WebhookDelivery, error) {
+	webhook, err := s.get(ctx, userID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{"message": "Evento de teste enviado pelo PostPilot"}
+	delivery, err := s.enqueue(ctx, webhook, models.WebhookTest, data)
+	if err != nil {
+		return nil, err
+	}
+	s.attempt(ctx, webhook, delivery, false)
+	return delivery, nil
+}
+
+// Dispatch queues event for every active webhook of the user subscribed to it and sends it in the
+// background. Failures are logged and retried by RetryDue; they never abort the action that triggered them.
+func (s *webhookService) Dispatch(ctx context.Context, userID primitive.ObjectID, event models.WebhookEvent, data interface{}) {
+	webhooks, err := s.webhookRepository.ListActiveByUser(ctx, userID)
+	if err != nil {
+		log.Logger.Warn("Failed to load webhooks", zap.String("userId", userID.Hex()), zap.String("event", string(event)), zap.Error(err))
+		return
+	}
+
+	for i := range webhooks {
+		webhook := &webhooks[i]
+		if !webhook.Subscribes(event) {
+			continue
+		}
+		delivery, err := s.enqueue(ctx, webhook, event, data)
+		if err != nil {
+			log.Logger.Warn("Failed to queue webhook delivery",
+				zap.String("webhookId", webhook.ID.Hex()),
+				zap.String("event", string(event)),
+				zap.Error(err),
+			)
+			continue
+		}
+		// The request context ends with the request, so the first attempt runs on its own
+		go s.attempt(context.Background(), webhook, delivery, true)
+	}
+}
+
+// RetryDue sends the pending deliveries whose next attempt is due
+func (s *webhookService) RetryDue(ctx context.Context) error {
+	webhooks := map[primitive.ObjectID]*models.Webhook{}
+	sent := 0
+	for sent < webhookRetryBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		delivery, err := s.deliveryRepository.ClaimDue(ctx, time.Now().UTC(), webhookClaimLease)
+		if err != nil {
+			return err
+		}
+		if delivery == nil {
+			break
+		}
+
+		webhook, ok := webhooks[delivery.WebhookID]
+		if !ok {
+			webhook, err = s.webhookRepository.GetByID(ctx, delivery.WebhookID)
+			if err != nil {
+				return err
+			}
+			webhooks[delivery.WebhookID] = webhook
+		}
+		if webhook == nil || !webhook.Active {
+			_ = s.deliveryRepository.Update(ctx, delivery.ID,
+				bson.M{"status": models.WebhookDeliveryFailed, "lastError": "webhook deleted or disabled"},
+				bson.M{"nextAttemptAt": ""},
+			)
+			continue
+		}
+
+		s.attempt(ctx, webhook, delivery, true)
+		sent++
+	}
+
+	if sent > 0 {
+		log.Logger.Info("Webhook deliveries retried", zap.Int("deliveries", sent))
+	}
+	return nil
+}
+
+func (s *webhookService) get(ctx context.Context, userID, webhookID primitive.ObjectID) (*models.Webhook, error) {
+	webhook, err := s.webhookRepository.GetByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+	if webhook == nil || webhook.UserID != userID {
+		return nil, ErrWebhookNotFound
+	}
+	return webhook, nil
+}
+
+// enqueue stores a pending delivery. Its first attempt is leased to the caller, which sends it right away.
+func (s *webhookService) enqueue(ctx context.Context, webhook *models.Webhook, event models.WebhookEvent, data interface{}) (*models.WebhookDelivery, error) {
+	now := time.Now().UTC()
+	id := primitive.NewObjectID()
+	body, err := json.Marshal(webhookPayload{ID: id.Hex(), Event: event, CreatedAt: now, Data: data})
+	if err != nil {
+		return nil, err
+	}
+
+	leaseUntil := now.Add(webhookClaimLease)
+	delivery := &models.WebhookDelivery{
+		ID:            id,
+		WebhookID:     webhook.ID,
+		UserID:        webhook.UserID,
+		Event:         event,
+		Body:          string(body),
+		Status:        models.WebhookDeliveryPending,
+		NextAttemptAt: &leaseUntil,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if _, err := s.deliveryRepository.Create(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// attempt sends a delivery once and records the outcome on it. Failed deliveries are rescheduled with
+// exponential backoff while retry is set and attempts remain; otherwise they are marked as failed.
+func (s *webhookService) attempt(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery, retry bool) {
+	statusCode, err := s.send(ctx, webhook, delivery)
+	now := time.Now().UTC()
+
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	set := bson.M{"attempts": delivery.Attempts, "lastStatusCode": statusCode}
+	unset := bson.M{}
+
+	switch {
+	case err == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &now
+		delivery.NextAttemptAt = nil
+		delivery.LastError = ""
+		set["status"] = delivery.Status
+		set["deliveredAt"] = now
+		unset["nextAttemptAt"] = ""
+		unset["lastError"] = ""
+	case retry && delivery.Attempts < s.maxAttempts:
+		next := now.Add(s.retryBaseDelay << (delivery.Attempts - 1))
+		delivery.NextAttemptAt = &next
+		delivery.LastError = err.Error()
+		set["nextAttemptAt"] = next
+		set["lastError"] = delivery.LastError
+	default:
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.NextAttemptAt = nil
+		delivery.LastError = err.Error()
+		set["status"] = delivery.Status
+		set["lastError"] = delivery.LastError
+		unset["nextAttemptAt"] = ""
+	}
+
+	if err != nil {
+		log.Logger.Warn("Webhook delivery failed",
+			zap.String("webhookId", webhook.ID.Hex()),
+			zap.String("deliveryId", delivery.ID.Hex()),
+			zap.String("event", string(delivery.Event)),
+			zap.Int("attempts", delivery.Attempts),
+			zap.String("status", string(delivery.Status)),
+			zap.Error(err),
+		)
+	}
+	if updateErr := s.deliveryRepository.Update(ctx, delivery.ID, set, unset); updateErr != nil {
+		log.Logger.Warn("Failed to record webhook delivery attempt", zap.String("deliveryId", delivery.ID.Hex()), zap.Error(updateErr))
+	}
+}
+
+// send posts the delivery body to the webhook URL, signed with the webhook secret.
+// Any response other than 2xx is an error.
+func (s *webhookService) send(ctx context.Context, webhook *models.Webhook, delivery *models.WebhookDelivery) (int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewBufferString(delivery.Body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "PostPilot-Webhooks/1.0")
+	req.Header.Set(webhookEventHeader, string(delivery.Event))
+	req.Header.Set(webhookDeliveryHeader, delivery.ID.Hex())
+	req.Header.Set(webhookTimestampHeader, timestamp)
+	req.Header.Set(webhookSignatureHeader, "sha256="+signWebhookPayload(webhook.Secret, timestamp, delivery.Body))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// validateWebhookURL only accepts http(s) URLs of public hosts, so webhooks cannot be used to reach
+// internal services. Host names are checked again on every delivery, when they are resolved.
+func validateWebhookURL(raw string) error {
+	if err := httpclient.ValidatePublicURL(raw); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidWebhookURL, err)
+	}
+	return nil
+}
+
+// signWebhookPayload computes the hex HMAC-SHA256 of "timestamp.body". Signing the timestamp
+// lets receivers reject replayed deliveries.
+func signWebhookPayload(secret, timestamp, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/postpilot/api/internal/httpclient"
+	"github.com/postpilot/api/internal/log"
+	"github.com/postpilot/api/internal/models"
+	"go.uber.org/zap"
+)
+
+// featureImageMaxBytes bounds the size of a feature image downloaded to be uploaded to a blog
+const featureImageMaxBytes = 10 << 20
+
+// WordPressClient publishes to WordPress sites through the REST API, authenticated with application passwords
+type WordPressClient struct {
+	httpClient *http.Client
+}
+
+// NewWordPressClient creates a WordPress client. Sites are given by users, so client should come from httpclient.NewPublic.
+func NewWordPressClient(client *httpclient.HTTPClient) *WordPressClient {
+	return &WordPressClient{httpClient: client.Client()}
+}
+
+// Verify checks the application password against the authenticated user endpoint and returns the site name
+func (c *WordPressClient) Verify(ctx context.Context, conn *models.BlogConnection) (string, error) {
+	if _, err := c.do(ctx, conn, http.MethodGet, "/users/me?context=edit", nil); err != nil {
+		return "", err
+	}
+
+	// The site name comes from the API index, which does not need authentication
+	var index struct {
+		Name string `json:"name"`
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(conn.SiteURL, "/")+"/wp-json/", nil)
+	if err == nil {
+		if resp, err := c.httpClient.Do(req); err == nil {
+			_ = json.NewDecoder(resp.Body).Decode(&index)
+			resp.Body.Close()
+		}
+	}
+	return index.Name, nil
+}
+
+// Publish creates a post, creating the tags that do not exist yet and uploading the feature image to the media library
+func (c *WordPressClient) Publish(ctx context.Context, conn *models.BlogConnection, article BlogArticle) (*BlogPostResult, error) {
+	post := map[string]interface{}{
+		"title":   article.Title,
+		"content": article.HTML,
+		"status":  "draft",
+	}
+	if article.Published {
+		post["status"] = "publish"
+	}
+
+	if len(article.Tags) > 0 {
+		tagIDs := make([]int64, 0, len(article.Tags))
+		for _, tag := range article.Tags {
+			id, err := c.tagID(ctx, conn, tag)
+			if err != nil {
+				return nil, err
+			}
+			tagIDs = append(tagIDs, id)
+		}
+		post["tags"] = tagIDs
+	}
+
+	if article.FeatureImageURL != "" {
+		mediaID, err := c.uploadImage(ctx, conn, article.FeatureImageURL)
+		if err != nil {
+			return nil, err
+		}
+		post["featured_media"] = mediaID
+	}
+
+	body, err := json.Marshal(post)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.do(ctx, conn, http.MethodPost, "/posts", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var created struct {
+		ID     int64  `json:"id"`
+		Link   string `json:"link"`
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(raw, &created); err != nil {
+		return nil, fmt.Errorf("failed to decode WordPress response: %w", err)
+	}
+	if created.ID == 0 {
+		return nil, errors.New("WordPress returned no post ID")
+	}
+	result := &BlogPostResult{ID: strconv.FormatInt(created.ID, 10), URL: created.Link, Status: created.Status}
+	_ = json.Unmarshal(raw, &result.Raw)
+	return result, nil
+}
+
+// tagID returns the ID of the tag with the given name, creating it when the site does not have it yet
+func (c *WordPressClient) tagID(ctx context.Context, conn *models.BlogConnection, name string) (int64, error) {
+	raw, err := c.do(ctx, conn, http.MethodGet, "/tags?per_page=100&search="+url.QueryEscape(name), nil)
+	if err != nil {
+		return 0, err
+	}
+	var tags []struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(raw, &tags); err != nil {
+		return 0, fmt.Errorf("failed to decode WordPress tags: %w", err)
+	}
+	for _, tag := range tags {
+		if strings.EqualFold(tag.Name, name) {
+			return tag.ID, nil
+		}
+	}
+
+	body, _ := json.Marshal(map[string]string{"name": name})
+	raw, err = c.do(ctx, conn, http.MethodPost, "/tags", bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	var created struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(raw, &created); err != nil {
+		return 0, fmt.Errorf("failed to decode WordPress tag: %w", err)
+	}
+	return created.ID, nil
+}
+
+// uploadImage downloads the image and uploads it to the site's media library, returning its media ID
+func (c *WordPressClient) uploadImage(ctx context.Context, conn *models.BlogConnection, imageURL string) (int64, error) {
+	data, contentType, err := downloadFeatureImage(ctx, c.httpClient, imageURL)
+	if err != nil {
+		return 0, err
+	}
+
+	filename := path.Base(urlPath(imageURL))
+	if filename == "" || filename == "." || filename == "/" {
+		filename = "feature-image"
+	}
+	req, err := c.newRequest(ctx, conn, http.MethodPost, "/media", bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+
+	raw, err := c.send(req, "/media")
+	if err != nil {
+		return 0, err
+	}
+	var media struct {
+		ID int64 `json:"id"`
+	}
+	if err := json.Unmarshal(raw, &media); err != nil {
+		return 0, fmt.Errorf("failed to decode WordPress media: %w", err)
+	}
+	return media.ID, nil
+}
+
+func (c *WordPressClient) newRequest(ctx context.Context, conn *models.BlogConnection, method, endpoint string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(conn.SiteURL, "/")+"/wp-json/wp/v2"+endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(conn.Username, conn.ApplicationPassword)
+	req.Header.Set("Accept", "application/json")
+	return req, nil
+}
+
+func (c *WordPressClient) do(ctx context.Context, conn *models.BlogConnection, method, endpoint string, body io.Reader) ([]byte, error) {
+	req, err := c.newRequest(ctx, conn, method, endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return c.send(req, endpoint)
+}
+
+func (c *WordPressClient) send(req *http.Request, endpoint string) ([]byte, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		log.Logger.Error("WordPress request failed", zap.String("host", req.URL.Host), zap.String("endpoint", endpoint), zap.Error(err))
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		log.Logger.Warn("WordPress credentials rejected", zap.String("host", req.URL.Host), zap.String("endpoint", endpoint), zap.Int("statusCode", resp.StatusCode))
+		return nil, ErrBlogUnauthorized
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Logger.Error("WordPress API error",
+			zap.String("host", req.URL.Host),
+			zap.String("endpoint", endpoint),
+			zap.Int("statusCode", resp.StatusCode),
+			zap.String("response", string(respBody)),
+		)
+		return nil, fmt.Errorf("WordPress API error (%d)", resp.StatusCode)
+	}
+	return respBody, nil
+}
+
+// downloadFeatureImage fetches an image to be uploaded to a blog, refusing anything that is not an image
+func downloadFeatureImage(ctx context.Context, client *http.Client, imageURL string) ([]byte, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, imageURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to download feature image: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("failed to download feature image: status %d", resp.StatusCode)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, "", fmt.Errorf("feature image URL is not an image (%s)", contentType)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, featureImageMaxBytes+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > featureImageMaxBytes {
+		return nil, "", fmt.Errorf("feature image is larger than %d MB", featureImageMaxBytes>>20)
+	}
+	return data, contentType, nil
+}
+
+// urlPath is the path of rawURL, or empty when it cannot be parsed
+func urlPath(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return u.Path
+}
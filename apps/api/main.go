@@ -15,6 +15,7 @@ import (
 	"github.com/postpilot/api/internal/config"
 	"github.com/postpilot/api/internal/db"
 	"github.com/postpilot/api/internal/di"
+	"github.com/postpilot/api/internal/jobs"
 	"github.com/postpilot/api/internal/log"
 	"github.com/postpilot/api/internal/middleware"
 	"go.uber.org/zap"
@@ -93,6 +94,17 @@ func main() {
 		application.AuthHandler,
 		application.ArticleHandler,
 		application.PostHandler,
+		application.StoryHandler,
+		application.CommentHandler,
+		application.ReviewHandler,
+		application.DraftHandler,
+		application.WebhookHandler,
+		application.InsightsHandler,
+		application.LinkHandler,
+		application.ImportHandler,
+		application.CalendarHandler,
+		application.DevToHandler,
+		application.BlogHandler,
 	)
 
 	go func() {
@@ -102,15 +114,19 @@ func main() {
 		}
 	}()
 
-	gracefulShutdown(fiberApp, cfg)
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	jobs.Start(jobsCtx, application.Jobs)
+
+	gracefulShutdown(fiberApp, cfg, stopJobs)
 }
 
-func gracefulShutdown(app *fiber.App, cfg *config.Config) {
+func gracefulShutdown(app *fiber.App, cfg *config.Config, stopJobs context.CancelFunc) {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	<-quit
 	log.Logger.Info("Shutting down server...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
@@ -25,13 +25,10 @@ import {
 } from '@/components/ui/select'
 import { useToast } from '@/components/ui/use-toast'
 import { useAuth } from '@/hooks/useAuth'
-import { authService } from '@/services/auth.service'
+import { authService, type DataSource } from '@/services/auth.service'
 
-interface DataSourceInput {
-  type: 'rss' | 'devto' | 'hackernews'
-  url: string
-  tags?: string[]
-}
+// Opções que o formulário não exibe, como as de fontes reddit, são mantidas ao salvar
+type DataSourceInput = DataSource
 
 interface SettingsFormValues {
   openaiApiKey: string
@@ -80,6 +77,7 @@ export function Settings() {
         dataSources:
           user.dataSources && user.dataSources.length > 0
             ? user.dataSources.map(ds => ({
+                ...ds,
                 type: ds.type ?? 'rss',
                 url: ds.url ?? '',
                 tags: ds.tags ?? [],
@@ -97,6 +95,7 @@ export function Settings() {
       const validDataSources = data.dataSources
         .filter(ds => ds.url.trim() !== '')
         .map(ds => ({
+          ...ds,
           type: ds.type,
           url: ds.url,
           tags: ds.tags ?? [],
@@ -312,6 +311,7 @@ export function Settings() {
                           <SelectItem value="rss">RSS</SelectItem>
                           <SelectItem value="devto">Dev.to</SelectItem>
                           <SelectItem value="hackernews">Hacker News</SelectItem>
+                          <SelectItem value="reddit">Reddit</SelectItem>
                         </SelectContent>
                       </Select>
                     )}
@@ -3,12 +3,16 @@ import { authUtils } from '@/utils/auth'
 
 export type AuthProvider = 'local' | 'google' | 'linkedin'
 
-export type DataSourceType = 'rss' | 'devto' | 'hackernews'
+export type DataSourceType = 'rss' | 'devto' | 'hackernews' | 'reddit'
 
 export interface DataSource {
   type: DataSourceType
   url: string
   tags?: string[]
+  listing?: 'hot' | 'top' | 'new'
+  window?: 'hour' | 'day' | 'week' | 'month' | 'year' | 'all'
+  minScore?: number
+  skipSelfPosts?: boolean
 }
 
 export interface User {
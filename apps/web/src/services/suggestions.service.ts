@@ -9,6 +9,17 @@ export interface Article {
   tags?: string[]
 }
 
+export interface SourceError {
+  type: string
+  url?: string
+  error: string
+}
+
+export interface ArticleSuggestions {
+  articles: Article[]
+  sourceErrors: SourceError[]
+}
+
 export interface TimeRecommendation {
   time: string
   engagement: number
@@ -49,14 +60,14 @@ class SuggestionsService {
     tags?: string[]
     limit?: number
   }): Promise<Article[]> {
-    const response = await api.get<Article[]>(this.SUGGESTIONS_ENDPOINTS.articles, {
+    const response = await api.get<ArticleSuggestions>(this.SUGGESTIONS_ENDPOINTS.articles, {
       params,
       headers: {
         Accept: 'application/json',
         'Content-Type': 'application/json',
       },
     })
-    return response.data.map(article => ({
+    return response.data.articles.map(article => ({
       ...article,
       url: decodeURIComponent(article.url),
     }))